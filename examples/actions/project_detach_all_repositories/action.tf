@@ -0,0 +1,7 @@
+# Invoke before destroying the project with:
+# terraform apply -invoke=action.project_detach_all_repositories.decommission
+action "project_detach_all_repositories" "decommission" {
+  config {
+    project_key = "myproj"
+  }
+}
@@ -0,0 +1,7 @@
+action "project_environment_rename" "staging" {
+  config {
+    project_key = "myproj"
+    name        = "stage"
+    new_name    = "staging"
+  }
+}
@@ -0,0 +1,18 @@
+action "project_sync_members_from_group" "developers" {
+  config {
+    project_key = "myproj"
+    group_name  = "developers"
+    roles       = ["Developer"]
+  }
+}
+
+resource "terraform_data" "developers" {
+  input = "developers"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.project_sync_members_from_group.developers]
+    }
+  }
+}
@@ -0,0 +1,10 @@
+data "project_member_by_group_expansion" "myproj" {
+  project_key = "myproj"
+}
+
+output "project_admins" {
+  value = [
+    for member in data.project_member_by_group_expansion.myproj.members : member.name
+    if contains(member.roles, "Project Admin")
+  ]
+}
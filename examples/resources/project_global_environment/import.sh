@@ -0,0 +1 @@
+terraform import project_global_environment.preprod PREPROD
\ No newline at end of file
@@ -0,0 +1,3 @@
+resource "project_global_environment" "preprod" {
+  name = "PREPROD"
+}
@@ -0,0 +1 @@
+terraform import project_groups.myproject project_key
\ No newline at end of file
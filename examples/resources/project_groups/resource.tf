@@ -0,0 +1,16 @@
+resource "project_groups" "myproject" {
+  project_key = "myproj"
+
+  groups = [
+    {
+      name  = "developers"
+      roles = ["Developer"]
+    },
+    {
+      name  = "release-managers"
+      roles = ["Viewer", "Release Manager"]
+    },
+  ]
+
+  authoritative = true
+}
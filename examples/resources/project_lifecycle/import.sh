@@ -0,0 +1 @@
+terraform import project_lifecycle.myproj project_key
\ No newline at end of file
@@ -0,0 +1,19 @@
+resource "project_lifecycle_stage" "qa" {
+  name         = "qa"
+  project_key  = "myproj"
+  environments = ["DEV"]
+}
+
+resource "project_lifecycle_stage" "prod" {
+  name         = "prod"
+  project_key  = "myproj"
+  environments = ["PROD"]
+}
+
+resource "project_lifecycle" "myproj" {
+  project_key = "myproj"
+  promote_stages = [
+    project_lifecycle_stage.qa.id,
+    project_lifecycle_stage.prod.id,
+  ]
+}
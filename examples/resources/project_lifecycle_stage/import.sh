@@ -0,0 +1 @@
+terraform import project_lifecycle_stage.qa project_key:stage_name
\ No newline at end of file
@@ -0,0 +1,10 @@
+resource "project_environment" "qa" {
+  name        = "qa"
+  project_key = "myproj"
+}
+
+resource "project_lifecycle_stage" "qa" {
+  name         = "qa"
+  project_key  = "myproj"
+  environments = [project_environment.qa.id]
+}
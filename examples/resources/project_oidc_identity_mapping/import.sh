@@ -0,0 +1 @@
+terraform import project_oidc_identity_mapping.github-ci myproj:github-oidc:github-ci
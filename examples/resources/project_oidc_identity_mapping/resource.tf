@@ -0,0 +1,17 @@
+resource "project_oidc_identity_mapping" "github-ci" {
+  name          = "github-ci"
+  description   = "GitHub Actions workflows of the main branch"
+  provider_name = "github-oidc"
+  project_key   = "myproj"
+  priority      = 1
+
+  claims_json = jsonencode({
+    repository = "myorg/myrepo"
+    ref        = "refs/heads/main"
+  })
+
+  token_spec = {
+    roles      = ["Developer"]
+    expires_in = 120
+  }
+}
@@ -0,0 +1,9 @@
+resource "project_repositories" "myproject" {
+  project_key = "myproj"
+  repos = [
+    "my-generic-local",
+    "my-maven-local",
+    "my-npm-remote",
+  ]
+  authoritative = true
+}
@@ -1,4 +1,5 @@
 resource "project_repository" "myprojectrepo" {
-  project_key = "myproj"
-  key         = "my-generic-local"
-}
\ No newline at end of file
+  project_key  = "myproj"
+  key          = "my-generic-local"
+  environments = ["DEV", "myproj-staging"]
+}
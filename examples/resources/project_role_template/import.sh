@@ -0,0 +1 @@
+terraform import project_role_template.developer developer:myproj1,myproj2
\ No newline at end of file
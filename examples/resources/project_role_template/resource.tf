@@ -0,0 +1,11 @@
+resource "project_role_template" "developer" {
+  name         = "developer"
+  environments = ["DEV"]
+  actions = [
+    "READ_REPOSITORY",
+    "ANNOTATE_REPOSITORY",
+    "DEPLOY_CACHE_REPOSITORY",
+    "READ_BUILD",
+  ]
+  project_keys = ["myproj1", "myproj2"]
+}
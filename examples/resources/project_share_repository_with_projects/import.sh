@@ -0,0 +1 @@
+terraform import project_share_repository_with_projects.myprojectsharerepo repo_key
\ No newline at end of file
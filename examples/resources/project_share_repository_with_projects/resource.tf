@@ -0,0 +1,5 @@
+resource "project_share_repository_with_projects" "myprojectsharerepo" {
+  repo_key                      = "myrepo-generic-local"
+  target_project_keys           = ["myproj1", "myproj2", "myproj3"]
+  read_only_target_project_keys = ["myproj3"]
+}
@@ -0,0 +1,16 @@
+resource "project_users" "myproject" {
+  project_key = "myproj"
+
+  users = [
+    {
+      name  = "myuser"
+      roles = ["Developer"]
+    },
+    {
+      name  = "anotheruser"
+      roles = ["Viewer", "Release Manager"]
+    },
+  ]
+
+  authoritative = true
+}
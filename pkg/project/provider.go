@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -24,6 +25,7 @@ var productId = "terraform-provider-project/" + Version
 
 // Ensure the implementation satisfies the provider.Provider interface.
 var _ provider.Provider = &ProjectProvider{}
+var _ provider.ProviderWithActions = &ProjectProvider{}
 
 type ProjectProvider struct {
 	Meta util.ProviderMetadata
@@ -184,8 +186,11 @@ func (p *ProjectProvider) Configure(ctx context.Context, req provider.ConfigureR
 
 	p.Meta = meta
 
+	project.ResetManagementRegistry(restyClient)
+
 	resp.DataSourceData = meta
 	resp.ResourceData = meta
+	resp.ActionData = meta
 }
 
 // Resources satisfies the provider.Provider interface for ProjectProvider.
@@ -193,18 +198,38 @@ func (p *ProjectProvider) Resources(ctx context.Context) []func() resource.Resou
 	return []func() resource.Resource{
 		project.NewProjectResource,
 		project.NewProjectEnvironmentResource,
+		project.NewProjectGlobalEnvironmentResource,
 		project.NewProjectGroupResource,
+		project.NewProjectGroupsResource,
+		project.NewProjectLifecycleResource,
+		project.NewProjectLifecycleStageResource,
+		project.NewProjectOIDCIdentityMappingResource,
+		project.NewProjectRepositoriesResource,
 		project.NewProjectRepositoryResource,
 		project.NewProjectRoleResource,
+		project.NewProjectRoleTemplateResource,
 		project.NewProjectShareRepositoryResource,
 		project.NewProjectShareRepositoryWithAllResource,
+		project.NewProjectShareRepositoryWithProjectsResource,
 		project.NewProjectUserResource,
+		project.NewProjectUsersResource,
 	}
 }
 
 // DataSources satisfies the provider.Provider interface for ProjectProvider.
 func (p *ProjectProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		project.NewProjectMemberByGroupExpansionDataSource,
+	}
+}
+
+// Actions satisfies the provider.ProviderWithActions interface for ProjectProvider.
+func (p *ProjectProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		project.NewProjectDetachAllRepositoriesAction,
+		project.NewProjectEnvironmentRenameAction,
+		project.NewProjectSyncMembersFromGroupAction,
+	}
 }
 
 func NewProvider() func() provider.Provider {
@@ -0,0 +1,111 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+)
+
+func NewProjectDetachAllRepositoriesAction() action.Action {
+	return &ProjectDetachAllRepositoriesAction{
+		TypeName: "project_detach_all_repositories",
+	}
+}
+
+type ProjectDetachAllRepositoriesAction struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ProjectDetachAllRepositoriesActionModel struct {
+	ProjectKey types.String `tfsdk:"project_key"`
+}
+
+func (a *ProjectDetachAllRepositoriesAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = a.TypeName
+}
+
+func (a *ProjectDetachAllRepositoriesAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "The key of the project from which all repositories should be detached.",
+			},
+		},
+		Description: "Detach every repository assigned to a project, including repositories that are not managed by Terraform (e.g. assigned through `project_repository` in another workspace or through the UI). This is typically triggered before decommissioning a project.\n\n" +
+			"->Actions are only available in Terraform 1.14 or later.",
+	}
+}
+
+func (a *ProjectDetachAllRepositoriesAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	a.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (a *ProjectDetachAllRepositoriesAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	lockName := "project_repository"
+	GlobalMutex.Lock(lockName)
+	defer GlobalMutex.Unlock(lockName)
+
+	var config ProjectDetachAllRepositoriesActionModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := config.ProjectKey.ValueString()
+
+	repoKeys, err := readRepos(ctx, projectKey, a.ProviderData.Client)
+	if err != nil {
+		unableToInvokeActionError(resp, fmt.Sprintf("failed to fetch repos for project: %s", err))
+		return
+	}
+
+	if len(repoKeys) == 0 {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("No repositories assigned to project %s", projectKey),
+		})
+		return
+	}
+
+	for _, repoKey := range repoKeys {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Detaching repository %s from project %s", repoKey, projectKey),
+		})
+
+		if err := deleteRepos(ctx, []string{repoKey}, a.ProviderData.Client); err != nil {
+			unableToInvokeActionError(resp, fmt.Sprintf("failed to delete repos for project: %s", err))
+			return
+		}
+	}
+
+	remainingRepoKeys, err := readRepos(ctx, projectKey, a.ProviderData.Client)
+	if err != nil {
+		unableToInvokeActionError(resp, fmt.Sprintf("failed to fetch repos for project: %s", err))
+		return
+	}
+	if len(remainingRepoKeys) > 0 {
+		unableToInvokeActionError(resp, fmt.Sprintf("repositories still assigned to project %s: %s", projectKey, strings.Join(remainingRepoKeys, ", ")))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Detached %d repositories from project %s: %s", len(repoKeys), projectKey, strings.Join(repoKeys, ", ")),
+	})
+}
@@ -0,0 +1,133 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	project "github.com/jfrog/terraform-provider-project/pkg/project/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectDetachAllRepositoriesAction_full(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+
+	repoKey1 := fmt.Sprintf("repo%d", testutil.RandomInt())
+	repoKey2 := fmt.Sprintf("repo%d", testutil.RandomInt())
+
+	params := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"repo_key_1":   repoKey1,
+		"repo_key_2":   repoKey2,
+	}
+
+	template := `
+		resource "artifactory_local_generic_repository" "{{ .repo_key_1 }}" {
+			key = "{{ .repo_key_1 }}"
+
+			lifecycle {
+				ignore_changes = ["project_key", "project_environments"]
+			}
+		}
+
+		resource "artifactory_local_generic_repository" "{{ .repo_key_2 }}" {
+			key = "{{ .repo_key_2 }}"
+
+			lifecycle {
+				ignore_changes = ["project_key", "project_environments"]
+			}
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+
+			use_project_repository_resource = false
+
+			repos = [
+				artifactory_local_generic_repository.{{ .repo_key_1 }}.key,
+				artifactory_local_generic_repository.{{ .repo_key_2 }}.key,
+			]
+
+			lifecycle {
+				ignore_changes = ["repos"]
+			}
+		}
+
+		action "project_detach_all_repositories" "detach" {
+			config {
+				project_key = project.{{ .project_name }}.key
+			}
+		}
+
+		resource "terraform_data" "trigger" {
+			input = project.{{ .project_name }}.key
+
+			lifecycle {
+				action_trigger {
+					events  = [after_create]
+					actions = [action.project_detach_all_repositories.detach]
+				}
+			}
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProjectDetachAllRepositoriesAction", template, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectRepoCount(t, projectKey, 0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProjectRepoCount(t *testing.T, projectKey string, expectedCount int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client := acctest.GetTestResty(t)
+
+		var repos []project.ArtifactoryRepo
+		resp, err := client.R().
+			SetQueryParam("project", projectKey).
+			SetResult(&repos).
+			Get("/artifactory/api/repositories")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return fmt.Errorf("%s", resp.String())
+		}
+
+		if len(repos) != expectedCount {
+			return fmt.Errorf("expected %d repositories assigned to project %s, got %d", expectedCount, projectKey, len(repos))
+		}
+
+		return nil
+	}
+}
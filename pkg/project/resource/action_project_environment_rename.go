@@ -0,0 +1,152 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+func NewProjectEnvironmentRenameAction() action.Action {
+	return &ProjectEnvironmentRenameAction{
+		TypeName: "project_environment_rename",
+	}
+}
+
+type ProjectEnvironmentRenameAction struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ProjectEnvironmentRenameActionModel struct {
+	ProjectKey types.String `tfsdk:"project_key"`
+	Name       types.String `tfsdk:"name"`
+	NewName    types.String `tfsdk:"new_name"`
+}
+
+func (a *ProjectEnvironmentRenameAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = a.TypeName
+}
+
+func (a *ProjectEnvironmentRenameAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "Project key of the environment.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(environmentNameRegex, "Must start with a letter and contain letters, digits and `-` character."),
+				},
+				Description: "Current environment name, without the project key prefix.",
+			},
+			"new_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(environmentNameRegex, "Must start with a letter and contain letters, digits and `-` character."),
+				},
+				Description: "New environment name, without the project key prefix.",
+			},
+		},
+		Description: "Rename a project environment and update every custom project role that references it, so the roles keep granting access in the renamed environment.\n\n" +
+			"~>If the environment is managed by a `project_environment` resource, update its `name` attribute instead, or the resource will detect the rename as drift.\n\n" +
+			"->Actions are only available in Terraform 1.14 or later.",
+	}
+}
+
+func (a *ProjectEnvironmentRenameAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	a.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (a *ProjectEnvironmentRenameAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config ProjectEnvironmentRenameActionModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := config.ProjectKey.ValueString()
+	oldEnvironmentName := fmt.Sprintf("%s-%s", projectKey, config.Name.ValueString())
+	newEnvironmentName := fmt.Sprintf("%s-%s", projectKey, config.NewName.ValueString())
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Renaming environment %s to %s", oldEnvironmentName, newEnvironmentName),
+	})
+
+	var projectError ProjectErrorsResponse
+	response, err := a.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"projectKey":      projectKey,
+			"environmentName": oldEnvironmentName,
+		}).
+		SetBody(ProjectEnvironmentUpdateAPIModel{
+			NewName: newEnvironmentName,
+		}).
+		SetError(&projectError).
+		Post(ProjectEnvironmentUrl + "/{environmentName}/rename")
+	if err != nil {
+		unableToInvokeActionError(resp, err.Error())
+		return
+	}
+	if response.IsError() {
+		unableToInvokeActionError(resp, projectError.String())
+		return
+	}
+
+	roles, err := readRoles(ctx, projectKey, a.ProviderData.Client)
+	if err != nil {
+		unableToInvokeActionError(resp, fmt.Sprintf("failed to fetch roles for project: %s", err))
+		return
+	}
+
+	for _, role := range roles {
+		if !lo.Contains(role.Environments, oldEnvironmentName) {
+			continue
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Updating environments of role %s", role.Name),
+		})
+
+		role.Environments = lo.Uniq(lo.Replace(role.Environments, oldEnvironmentName, newEnvironmentName, -1))
+		if err := updateRole(ctx, projectKey, role, a.ProviderData.Client); err != nil {
+			unableToInvokeActionError(resp, fmt.Sprintf("failed to update role %s: %s", role.Name, err))
+			return
+		}
+	}
+}
+
+func (a *ProjectEnvironmentRenameAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var config ProjectEnvironmentRenameActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := fmt.Sprintf("%s-%s", config.ProjectKey.ValueString(), config.NewName.ValueString())
+	if len(name) > 32 {
+		resp.Diagnostics.AddError(
+			"Invalid Attributes Configuration",
+			"Combined length of project_key and new_name (separated by '-') cannot exceed 32 characters",
+		)
+		return
+	}
+}
@@ -0,0 +1,139 @@
+package project_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	project "github.com/jfrog/terraform-provider-project/pkg/project/resource"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectEnvironmentRenameAction_full(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	envName := strings.ToLower(acctest.RandSeq(8))
+	newEnvName := strings.ToLower(acctest.RandSeq(8))
+	roleName := fmt.Sprintf("role%s", strings.ToLower(acctest.RandSeq(6)))
+
+	params := map[string]any{
+		"project_key":  projectKey,
+		"env_name":     envName,
+		"new_env_name": newEnvName,
+		"role_name":    roleName,
+	}
+
+	projectConfig := util.ExecuteTemplate("TestAccProjectEnvironmentRenameAction", `
+		resource "project" "{{ .project_key }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_key }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+	`, params)
+
+	config := util.ExecuteTemplate("TestAccProjectEnvironmentRenameAction", `
+		resource "project" "{{ .project_key }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_key }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_role" "{{ .role_name }}" {
+			name         = "{{ .role_name }}"
+			type         = "CUSTOM"
+			project_key  = project.{{ .project_key }}.key
+			environments = ["{{ .project_key }}-{{ .env_name }}"]
+			actions      = ["READ_REPOSITORY"]
+
+			lifecycle {
+				ignore_changes = ["environments"]
+			}
+		}
+
+		action "project_environment_rename" "rename" {
+			config {
+				project_key = project.{{ .project_key }}.key
+				name        = "{{ .env_name }}"
+				new_name    = "{{ .new_env_name }}"
+			}
+		}
+
+		resource "terraform_data" "trigger" {
+			input = project_role.{{ .role_name }}.name
+
+			lifecycle {
+				action_trigger {
+					events  = [after_create]
+					actions = [action.project_environment_rename.rename]
+				}
+			}
+		}
+	`, params)
+
+	createEnvironment := func() {
+		resp, err := acctest.GetTestResty(t).R().
+			SetPathParam("projectKey", projectKey).
+			SetBody(project.ProjectEnvironmentAPIModel{
+				Name: fmt.Sprintf("%s-%s", projectKey, envName),
+			}).
+			Post(project.ProjectEnvironmentUrl)
+		if err != nil {
+			t.Fatalf("failed to create environment: %v", err)
+		}
+		if resp.IsError() {
+			t.Fatalf("failed to create environment: %s", resp.String())
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: projectConfig,
+			},
+			{
+				PreConfig: createEnvironment,
+				Config:    config,
+				Check: func(_ *terraform.State) error {
+					var role project.Role
+					resp, err := acctest.GetTestResty(t).R().
+						SetPathParams(map[string]string{
+							"projectKey": projectKey,
+							"roleName":   roleName,
+						}).
+						SetResult(&role).
+						Get(project.ProjectRoleUrl)
+					if err != nil {
+						return err
+					}
+					if resp.IsError() {
+						return fmt.Errorf("%s", resp.String())
+					}
+
+					newEnvironmentName := fmt.Sprintf("%s-%s", projectKey, newEnvName)
+					if !slices.Contains(role.Environments, newEnvironmentName) {
+						return fmt.Errorf("expected role %s to reference environment %s, got %v", roleName, newEnvironmentName, role.Environments)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
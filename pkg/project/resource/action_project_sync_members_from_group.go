@@ -0,0 +1,138 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+func NewProjectSyncMembersFromGroupAction() action.Action {
+	return &ProjectSyncMembersFromGroupAction{
+		TypeName: "project_sync_members_from_group",
+	}
+}
+
+type ProjectSyncMembersFromGroupAction struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ProjectSyncMembersFromGroupActionModel struct {
+	ProjectKey types.String `tfsdk:"project_key"`
+	GroupName  types.String `tfsdk:"group_name"`
+	Roles      types.Set    `tfsdk:"roles"`
+}
+
+func (a *ProjectSyncMembersFromGroupAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = a.TypeName
+}
+
+func (a *ProjectSyncMembersFromGroupAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "The key of the project to which the users should be added.",
+			},
+			"group_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "The name of the platform group whose users should become project members.",
+			},
+			"roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				Description: "List of pre-defined Project or custom roles granted to each user. Roles the users already hold in the project are kept.",
+			},
+		},
+		Description: "Add every user of a platform group as a direct project member with the given roles. Users that are already project members keep their existing roles in addition to the given ones. Project members that are not in the group are left untouched.\n\n" +
+			"->Actions are only available in Terraform 1.14 or later.",
+	}
+}
+
+func (a *ProjectSyncMembersFromGroupAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	a.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (a *ProjectSyncMembersFromGroupAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config ProjectSyncMembersFromGroupActionModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := config.ProjectKey.ValueString()
+	groupName := config.GroupName.ValueString()
+
+	var roles []string
+	resp.Diagnostics.Append(config.Roles.ElementsAs(ctx, &roles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupUsers, err := readGroupUsers(ctx, groupName, a.ProviderData.Client)
+	if err != nil {
+		unableToInvokeActionError(resp, fmt.Sprintf("failed to fetch users for group: %s", err))
+		return
+	}
+
+	projectMembers, err := readMembers(ctx, projectKey, usersMembershipType, a.ProviderData.Client)
+	if err != nil {
+		unableToInvokeActionError(resp, fmt.Sprintf("failed to fetch memberships for project: %s", err))
+		return
+	}
+
+	projectMemberRoles := lo.SliceToMap(projectMembers, func(member MemberAPIModel) (string, []string) {
+		return member.Name, member.Roles
+	})
+
+	updatedCount := 0
+	for _, userName := range groupUsers {
+		existingRoles := projectMemberRoles[userName]
+		memberRoles := lo.Union(existingRoles, roles)
+		if len(existingRoles) > 0 && len(memberRoles) == len(existingRoles) {
+			continue
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Adding user %s to project %s", userName, projectKey),
+		})
+
+		member := MemberAPIModel{
+			Name:  userName,
+			Roles: memberRoles,
+		}
+		if err := updateMember(ctx, projectKey, usersMembershipType, member, a.ProviderData.Client); err != nil {
+			unableToInvokeActionError(resp, fmt.Sprintf("failed to update members %s: %s", member.Name, err))
+			return
+		}
+		updatedCount++
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Synced %d of %d users from group %s to project %s", updatedCount, len(groupUsers), groupName, projectKey),
+	})
+}
@@ -0,0 +1,120 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	project "github.com/jfrog/terraform-provider-project/pkg/project/resource"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectSyncMembersFromGroupAction_full(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+
+	username1 := fmt.Sprintf("user1%s", strings.ToLower(acctest.RandSeq(5)))
+	username2 := fmt.Sprintf("user2%s", strings.ToLower(acctest.RandSeq(5)))
+	groupName := fmt.Sprintf("group%s", strings.ToLower(acctest.RandSeq(5)))
+
+	params := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"username1":    username1,
+		"username2":    username2,
+		"group_name":   groupName,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectSyncMembersFromGroupAction", `
+		resource "artifactory_managed_user" "{{ .username1 }}" {
+			name     = "{{ .username1 }}"
+			email    = "{{ .username1 }}@tempurl.org"
+			password = "Password!123"
+		}
+
+		resource "artifactory_managed_user" "{{ .username2 }}" {
+			name     = "{{ .username2 }}"
+			email    = "{{ .username2 }}@tempurl.org"
+			password = "Password!123"
+		}
+
+		resource "artifactory_group" "{{ .group_name }}" {
+			name = "{{ .group_name }}"
+			users_names = [
+				artifactory_managed_user.{{ .username1 }}.name,
+				artifactory_managed_user.{{ .username2 }}.name,
+			]
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		action "project_sync_members_from_group" "sync" {
+			config {
+				project_key = project.{{ .project_name }}.key
+				group_name  = artifactory_group.{{ .group_name }}.name
+				roles       = ["Viewer"]
+			}
+		}
+
+		resource "terraform_data" "trigger" {
+			input = artifactory_group.{{ .group_name }}.name
+
+			lifecycle {
+				action_trigger {
+					events  = [after_create]
+					actions = [action.project_sync_members_from_group.sync]
+				}
+			}
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(_ *terraform.State) error {
+					for _, username := range []string{username1, username2} {
+						var user project.ProjectUserAPIModel
+						resp, err := acctest.GetTestResty(t).R().
+							SetPathParams(map[string]string{
+								"projectKey": projectKey,
+								"name":       username,
+							}).
+							SetResult(&user).
+							Get(project.ProjectUsersUrl)
+						if err != nil {
+							return err
+						}
+						if resp.IsError() {
+							return fmt.Errorf("user %s is not a member of project %s: %s", username, projectKey, resp.String())
+						}
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,185 @@
+package project
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/samber/lo"
+)
+
+const rolesManagementType = "roles"
+const repositoriesManagementType = "repositories"
+
+// managementType describes how one kind of project sub-resource can be managed, either
+// through a nested block/attribute of the `project` resource or through a standalone resource.
+type managementType struct {
+	Kind               string
+	NestedAttribute    string
+	UseResourceFlag    string
+	StandaloneResource string
+}
+
+var usersManagement = managementType{
+	Kind:               usersMembershipType,
+	NestedAttribute:    "member",
+	UseResourceFlag:    "use_project_user_resource",
+	StandaloneResource: "project_user",
+}
+
+var groupsManagement = managementType{
+	Kind:               groupsMembershipType,
+	NestedAttribute:    "group",
+	UseResourceFlag:    "use_project_group_resource",
+	StandaloneResource: "project_group",
+}
+
+var rolesManagement = managementType{
+	Kind:               rolesManagementType,
+	NestedAttribute:    "role",
+	UseResourceFlag:    "use_project_role_resource",
+	StandaloneResource: "project_role",
+}
+
+var repositoriesManagement = managementType{
+	Kind:               repositoriesManagementType,
+	NestedAttribute:    "repos",
+	UseResourceFlag:    "use_project_repository_resource",
+	StandaloneResource: "project_repository",
+}
+
+// managementRegistries records, during planning, which project sub-resources are
+// claimed by the `project` nested blocks and which by the standalone resources. Both
+// sides register their claims in ModifyPlan so the conflict is reported whichever
+// resource is planned first, and release the claims of their prior state first, so a
+// resource that is destroyed, renamed, or moved to another project no longer conflicts.
+//
+// There is one registry per provider instance, keyed by its API client, and it is cleared
+// each time the provider is configured, i.e. once per plan or apply. Conflicts with
+// resources of other configurations or workspaces are therefore not detected; the
+// `project` resource only warns about sub-resources it finds in the API but not in its plan.
+var managementRegistries sync.Map
+
+// ResetManagementRegistry starts an empty registry for the provider instance using the client.
+func ResetManagementRegistry(client *resty.Client) {
+	managementRegistries.Store(client, newManagementRegistry())
+}
+
+var managementRegistryFor = func(client *resty.Client) *managementRegistry {
+	registry, _ := managementRegistries.LoadOrStore(client, newManagementRegistry())
+	return registry.(*managementRegistry)
+}
+
+type managementClaims struct {
+	nested     bool
+	standalone map[string]bool
+}
+
+type managementRegistry struct {
+	lock  sync.Mutex
+	store map[string]*managementClaims
+}
+
+func (m *managementRegistry) get(projectKey, kind string) *managementClaims {
+	key := fmt.Sprintf("%s/%s", projectKey, kind)
+	claims, ok := m.store[key]
+	if !ok {
+		claims = &managementClaims{
+			standalone: map[string]bool{},
+		}
+		m.store[key] = claims
+	}
+	return claims
+}
+
+// ClaimNested registers that the `project` resource manages the given kind of
+// sub-resources for the project. Returns the names already claimed by standalone resources.
+func (m *managementRegistry) ClaimNested(projectKey, kind string) []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	claims := m.get(projectKey, kind)
+	claims.nested = true
+
+	names := lo.Keys(claims.standalone)
+	sort.Strings(names)
+	return names
+}
+
+// ClaimStandalone registers that a standalone resource manages the named sub-resource
+// for the project. Returns true if the `project` resource already claimed the same kind.
+func (m *managementRegistry) ClaimStandalone(projectKey, kind, name string) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	claims := m.get(projectKey, kind)
+	claims.standalone[name] = true
+
+	return claims.nested
+}
+
+// ReleaseNested removes the `project` resource's claim, e.g. when the matching
+// `use_project_*_resource` attribute is set back to true or the project is destroyed.
+func (m *managementRegistry) ReleaseNested(projectKey, kind string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.get(projectKey, kind).nested = false
+}
+
+// ReleaseStandalone removes a standalone resource's claim when it is destroyed.
+func (m *managementRegistry) ReleaseStandalone(projectKey, kind, name string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.get(projectKey, kind).standalone, name)
+}
+
+func newManagementRegistry() *managementRegistry {
+	return &managementRegistry{
+		store: make(map[string]*managementClaims),
+	}
+}
+
+func overlappingManagementError(projectKey string, management managementType, names []string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		fmt.Sprintf("Conflicting management of project %s", management.Kind),
+		fmt.Sprintf(
+			"The %s of project '%s' are managed by the `%s` attribute of the `project` resource (`%s = false`) and by `%s` resources (%s).\n\n"+
+				"The `project` resource removes every one of its %s that is not declared in `%s`, while the `%s` resources add them back, causing endless drift.\n\n"+
+				"Either set `%s = true` and manage all %s with `%s` resources, or remove the `%s` resources and declare them in `%s`.",
+			management.Kind, projectKey, management.NestedAttribute, management.UseResourceFlag, management.StandaloneResource, strings.Join(names, ", "),
+			management.Kind, management.NestedAttribute, management.StandaloneResource,
+			management.UseResourceFlag, management.Kind, management.StandaloneResource, management.StandaloneResource, management.NestedAttribute,
+		),
+	)
+}
+
+func unmanagedRemovalWarning(projectKey string, management managementType, names []string) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		fmt.Sprintf("Project %s not declared in configuration will be removed", management.Kind),
+		fmt.Sprintf(
+			"Project '%s' has %s that are neither declared in `%s` nor previously managed by this resource: %s.\n\n"+
+				"Because `%s = false`, applying this plan removes them. If they are managed by `%s` resources (for example in another workspace), "+
+				"both sides will keep overwriting each other. Set `%s = true`, or declare them in `%s`.",
+			projectKey, management.Kind, management.NestedAttribute, strings.Join(names, ", "),
+			management.UseResourceFlag, management.StandaloneResource,
+			management.UseResourceFlag, management.NestedAttribute,
+		),
+	)
+}
+
+// checkStandaloneClaim registers a standalone resource's claim and reports an error if the
+// `project` resource manages the same kind of sub-resources for the project.
+func checkStandaloneClaim(projectKey string, management managementType, name string, client *resty.Client) diag.Diagnostics {
+	ds := diag.Diagnostics{}
+
+	if managementRegistryFor(client).ClaimStandalone(projectKey, management.Kind, name) {
+		ds.Append(overlappingManagementError(projectKey, management, []string{name}))
+	}
+
+	return ds
+}
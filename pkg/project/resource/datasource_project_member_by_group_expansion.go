@@ -0,0 +1,278 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+const (
+	grantSourceDirect = "direct"
+	grantSourceGroup  = "group"
+)
+
+func NewProjectMemberByGroupExpansionDataSource() datasource.DataSource {
+	return &ProjectMemberByGroupExpansionDataSource{
+		TypeName: "project_member_by_group_expansion",
+	}
+}
+
+type ProjectMemberByGroupExpansionDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ProjectMemberByGroupExpansionDataSourceModel struct {
+	ProjectKey types.String `tfsdk:"project_key"`
+	Members    types.List   `tfsdk:"members"`
+}
+
+var memberGrantAttrTypes = map[string]attr.Type{
+	"role":   types.StringType,
+	"source": types.StringType,
+	"group":  types.StringType,
+}
+
+var memberGrantElemType = types.ObjectType{
+	AttrTypes: memberGrantAttrTypes,
+}
+
+var effectiveMemberAttrTypes = map[string]attr.Type{
+	"name":   types.StringType,
+	"roles":  types.SetType{ElemType: types.StringType},
+	"grants": types.ListType{ElemType: memberGrantElemType},
+}
+
+var effectiveMemberElemType = types.ObjectType{
+	AttrTypes: effectiveMemberAttrTypes,
+}
+
+// memberGrant is a role held by a user in the project, either directly or through a group
+type memberGrant struct {
+	Role   string
+	Source string
+	Group  string
+}
+
+func (d *ProjectMemberByGroupExpansionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = d.TypeName
+}
+
+func (d *ProjectMemberByGroupExpansionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "The key of the project whose members are listed.",
+			},
+			"members": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the user.",
+						},
+						"roles": schema.SetAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "The effective project roles of the user, combining the direct and the group grants.",
+						},
+						"grants": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"role": schema.StringAttribute{
+										Computed:    true,
+										Description: "The granted role.",
+									},
+									"source": schema.StringAttribute{
+										Computed:    true,
+										Description: "How the role is granted: `direct` for a project user membership, or `group` for a project group membership.",
+									},
+									"group": schema.StringAttribute{
+										Computed:    true,
+										Description: "The name of the group granting the role. Null for `direct` grants.",
+									},
+								},
+							},
+							Description: "Every grant of a role to the user, with its source, sorted by role, then the direct grant first, then by group name. A role granted both directly and through groups is listed once per source.",
+						},
+					},
+				},
+				Description: "The users with access to the project, sorted by name.",
+			},
+		},
+		Description: "List the effective project roles of every user, expanding the project groups into their users. Each role is reported with the source of the grant: a direct `project_user` membership, or the `project_group` membership of one of the user's groups.",
+	}
+}
+
+func (d *ProjectMemberByGroupExpansionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+var expandMemberGrants = func(ctx context.Context, projectKey string, client *resty.Client) (map[string][]memberGrant, error) {
+	users, err := readMembers(ctx, projectKey, usersMembershipType, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch users for project: %s", err)
+	}
+
+	groups, err := readMembers(ctx, projectKey, groupsMembershipType, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch groups for project: %s", err)
+	}
+
+	grants := map[string][]memberGrant{}
+	for _, user := range users {
+		for _, role := range user.Roles {
+			grants[user.Name] = append(grants[user.Name], memberGrant{
+				Role:   role,
+				Source: grantSourceDirect,
+			})
+		}
+	}
+
+	for _, group := range groups {
+		groupUsers, err := readGroupUsers(ctx, group.Name, client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch users for group %s: %s", group.Name, err)
+		}
+
+		for _, userName := range groupUsers {
+			for _, role := range group.Roles {
+				grants[userName] = append(grants[userName], memberGrant{
+					Role:   role,
+					Source: grantSourceGroup,
+					Group:  group.Name,
+				})
+			}
+		}
+	}
+
+	return grants, nil
+}
+
+func memberGrantsToResourceList(ctx context.Context, grants map[string][]memberGrant) (types.List, diag.Diagnostics) {
+	ds := diag.Diagnostics{}
+
+	userNames := lo.Keys(grants)
+	sort.Strings(userNames)
+
+	members := lo.Map(
+		userNames,
+		func(userName string, _ int) attr.Value {
+			userGrants := grants[userName]
+			// by role, then the direct grant first, then by group name
+			sort.SliceStable(userGrants, func(i, j int) bool {
+				if userGrants[i].Role != userGrants[j].Role {
+					return userGrants[i].Role < userGrants[j].Role
+				}
+				if userGrants[i].Source != userGrants[j].Source {
+					return userGrants[i].Source == grantSourceDirect
+				}
+				return userGrants[i].Group < userGrants[j].Group
+			})
+
+			grantValues := lo.Map(
+				userGrants,
+				func(grant memberGrant, _ int) attr.Value {
+					group := types.StringNull()
+					if grant.Source == grantSourceGroup {
+						group = types.StringValue(grant.Group)
+					}
+
+					g, d := types.ObjectValue(
+						memberGrantAttrTypes,
+						map[string]attr.Value{
+							"role":   types.StringValue(grant.Role),
+							"source": types.StringValue(grant.Source),
+							"group":  group,
+						},
+					)
+					if d.HasError() {
+						ds.Append(d...)
+					}
+					return g
+				},
+			)
+
+			gs, d := types.ListValue(memberGrantElemType, grantValues)
+			if d.HasError() {
+				ds.Append(d...)
+			}
+
+			roles := lo.Uniq(lo.Map(userGrants, func(grant memberGrant, _ int) string {
+				return grant.Role
+			}))
+			rs, d := types.SetValueFrom(ctx, types.StringType, roles)
+			if d.HasError() {
+				ds.Append(d...)
+			}
+
+			m, d := types.ObjectValue(
+				effectiveMemberAttrTypes,
+				map[string]attr.Value{
+					"name":   types.StringValue(userName),
+					"roles":  rs,
+					"grants": gs,
+				},
+			)
+			if d.HasError() {
+				ds.Append(d...)
+			}
+			return m
+		},
+	)
+
+	if ds.HasError() {
+		return types.ListNull(effectiveMemberElemType), ds
+	}
+
+	return types.ListValue(effectiveMemberElemType, members)
+}
+
+func (d *ProjectMemberByGroupExpansionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	go util.SendUsage(ctx, d.ProviderData.Client.R(), d.ProviderData.ProductId, d.TypeName)
+
+	var config ProjectMemberByGroupExpansionDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grants, err := expandMemberGrants(ctx, config.ProjectKey.ValueString(), d.ProviderData.Client)
+	if err != nil {
+		unableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	members, ds := memberGrantsToResourceList(ctx, grants)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Members = members
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
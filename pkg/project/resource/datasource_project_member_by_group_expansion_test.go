@@ -0,0 +1,123 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectMemberByGroupExpansionDataSource_full(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+
+	username1 := fmt.Sprintf("user1%s", strings.ToLower(acctest.RandSeq(5)))
+	username2 := fmt.Sprintf("user2%s", strings.ToLower(acctest.RandSeq(5)))
+	groupName := fmt.Sprintf("group%s", strings.ToLower(acctest.RandSeq(5)))
+
+	dataSourceName := "data.project_member_by_group_expansion.test"
+
+	params := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"username1":    username1,
+		"username2":    username2,
+		"group_name":   groupName,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectMemberByGroupExpansionDataSource", `
+		resource "artifactory_managed_user" "{{ .username1 }}" {
+			name     = "{{ .username1 }}"
+			email    = "{{ .username1 }}@tempurl.org"
+			password = "Password!123"
+		}
+
+		resource "artifactory_managed_user" "{{ .username2 }}" {
+			name     = "{{ .username2 }}"
+			email    = "{{ .username2 }}@tempurl.org"
+			password = "Password!123"
+		}
+
+		resource "artifactory_group" "{{ .group_name }}" {
+			name = "{{ .group_name }}"
+			users_names = [
+				artifactory_managed_user.{{ .username1 }}.name,
+				artifactory_managed_user.{{ .username2 }}.name,
+			]
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_user" "{{ .username1 }}" {
+			project_key = project.{{ .project_name }}.key
+			name        = artifactory_managed_user.{{ .username1 }}.name
+			roles       = ["Developer", "Viewer"]
+		}
+
+		resource "project_group" "{{ .group_name }}" {
+			project_key = project.{{ .project_name }}.key
+			name        = artifactory_group.{{ .group_name }}.name
+			roles       = ["Viewer"]
+		}
+
+		data "project_member_by_group_expansion" "test" {
+			project_key = project.{{ .project_name }}.key
+
+			depends_on = [
+				project_user.{{ .username1 }},
+				project_group.{{ .group_name }},
+			]
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "project_key", projectKey),
+					resource.TestCheckResourceAttr(dataSourceName, "members.#", "2"),
+
+					resource.TestCheckResourceAttr(dataSourceName, "members.0.name", username1),
+					resource.TestCheckResourceAttr(dataSourceName, "members.0.roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "members.0.roles.*", "Developer"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "members.0.roles.*", "Viewer"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.0.grants.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.0.grants.0.role", "Developer"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.0.grants.0.source", "direct"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "members.0.grants.0.group"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.0.grants.1.role", "Viewer"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.0.grants.1.source", "direct"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.0.grants.2.role", "Viewer"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.0.grants.2.source", "group"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.0.grants.2.group", groupName),
+
+					resource.TestCheckResourceAttr(dataSourceName, "members.1.name", username2),
+					resource.TestCheckResourceAttr(dataSourceName, "members.1.roles.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.1.roles.0", "Viewer"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.1.grants.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.1.grants.0.source", "group"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.1.grants.0.group", groupName),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,155 @@
+package project
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+// readAvailableEnvironments returns the names of all the environments available to the project,
+// i.e. the global environments and the ones that belong to the project.
+var readAvailableEnvironments = func(ctx context.Context, projectKey string, client *resty.Client) ([]string, error) {
+	tflog.Debug(ctx, "readAvailableEnvironments")
+
+	var environments []ProjectEnvironmentAPIModel
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetPathParam("projectKey", projectKey).
+		SetResult(&environments).
+		SetError(&projectError).
+		Get(ProjectEnvironmentUrl)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", errProjectNotFound, projectError.String())
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("%s", projectError.String())
+	}
+
+	return lo.Map(environments, func(env ProjectEnvironmentAPIModel, _ int) string {
+		return env.Name
+	}), nil
+}
+
+// readGlobalEnvironments returns the names of the platform-wide environments, available to every project.
+var readGlobalEnvironments = func(ctx context.Context, client *resty.Client) ([]string, error) {
+	tflog.Debug(ctx, "readGlobalEnvironments")
+
+	var environments []ProjectEnvironmentAPIModel
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetResult(&environments).
+		SetError(&projectError).
+		Get(GlobalEnvironmentUrl)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("%s", projectError.String())
+	}
+
+	return lo.Map(environments, func(env ProjectEnvironmentAPIModel, _ int) string {
+		return env.Name
+	}), nil
+}
+
+// readEnvironments returns the names of the environments that belong to the project, i.e. the ones
+// listed for the project that are not global environments. Names are not matched on the project key
+// prefix, which global environments and environments renamed outside Terraform may not follow.
+var readEnvironments = func(ctx context.Context, projectKey string, client *resty.Client) ([]string, error) {
+	tflog.Debug(ctx, "readEnvironments")
+
+	environments, err := readAvailableEnvironments(ctx, projectKey, client)
+	if err != nil {
+		return nil, err
+	}
+
+	globalEnvironments, err := readGlobalEnvironments(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	projectEnvironments, _ := lo.Difference(environments, globalEnvironments)
+	return projectEnvironments, nil
+}
+
+// checkEnvironmentsExist returns an error listing the environments that are not available to the project.
+var checkEnvironmentsExist = func(ctx context.Context, projectKey string, environments []string, client *resty.Client) error {
+	availableEnvironments, err := readAvailableEnvironments(ctx, projectKey, client)
+	if err != nil {
+		return fmt.Errorf("failed to fetch environments for project %s: %s", projectKey, err)
+	}
+
+	missingEnvironments, _ := lo.Difference(environments, availableEnvironments)
+	if len(missingEnvironments) > 0 {
+		return fmt.Errorf("environments %s do not exist in project %s, available environments: %s", formatNames(missingEnvironments), projectKey, formatNames(availableEnvironments))
+	}
+
+	return nil
+}
+
+// checkPlannedEnvironments verifies at plan time that the environments exist in the project. Environments
+// created in the same apply are not known yet, so missing environments are reported as a warning and
+// verified again before the assignment. Nothing is verified when the environments or the project are not known yet.
+func checkPlannedEnvironments(ctx context.Context, projectKey string, environments types.Set, client *resty.Client) diag.Diagnostics {
+	ds := diag.Diagnostics{}
+
+	if client == nil || environments.IsNull() || environments.IsUnknown() {
+		return ds
+	}
+
+	environmentNames := []string{}
+	for _, elem := range environments.Elements() {
+		name, ok := elem.(types.String)
+		if !ok || name.IsUnknown() {
+			return ds
+		}
+		environmentNames = append(environmentNames, name.ValueString())
+	}
+
+	if len(environmentNames) == 0 {
+		return ds
+	}
+
+	err := checkEnvironmentsExist(ctx, projectKey, environmentNames, client)
+	if err != nil && !errors.Is(err, errProjectNotFound) {
+		ds.AddAttributeWarning(
+			path.Root("environments"),
+			"Unknown Project Environments",
+			err.Error()+". The apply fails unless the environments are created before the assignment, e.g. by a `project_environment` resource referenced by `id`.",
+		)
+	}
+
+	return ds
+}
+
+var deleteEnvironment = func(ctx context.Context, projectKey, environmentName string, client *resty.Client) error {
+	tflog.Debug(ctx, fmt.Sprintf("deleteEnvironment: %s", environmentName))
+
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetPathParams(map[string]string{
+			"projectKey":      projectKey,
+			"environmentName": environmentName,
+		}).
+		SetError(&projectError).
+		Delete(ProjectEnvironmentUrl + "/{environmentName}")
+	if err != nil {
+		return err
+	}
+	if resp.IsError() && resp.StatusCode() != http.StatusNotFound {
+		return fmt.Errorf("%s", projectError.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+const (
+	LifecycleStagesUrl = "/access/api/v2/stages"
+	LifecycleUrl       = "/access/api/v2/lifecycle"
+
+	lifecycleStageCategoryPromote = "promote"
+	lifecycleStageCategoryCode    = "code"
+)
+
+type LifecycleStageAPIModel struct {
+	Name         string   `json:"name"`
+	Scope        string   `json:"scope,omitempty"`
+	ProjectKey   string   `json:"project_key,omitempty"`
+	Category     string   `json:"category,omitempty"`
+	Environments []string `json:"environments"`
+}
+
+type LifecycleStageUpdateAPIModel struct {
+	Environments []string `json:"environments"`
+}
+
+type LifecycleCategoryAPIModel struct {
+	Category string                   `json:"category"`
+	Stages   []LifecycleStageAPIModel `json:"stages"`
+}
+
+type LifecycleAPIModel struct {
+	Categories []LifecycleCategoryAPIModel `json:"categories"`
+}
+
+type LifecycleUpdateAPIModel struct {
+	PromoteStages []string `json:"promote_stages"`
+}
+
+// readLifecycleStages returns the lifecycle stages available to the project, i.e. the global stages
+// and the ones that belong to the project.
+var readLifecycleStages = func(ctx context.Context, projectKey string, client *resty.Client) ([]LifecycleStageAPIModel, error) {
+	tflog.Debug(ctx, "readLifecycleStages")
+
+	var stages []LifecycleStageAPIModel
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetQueryParam("project_key", projectKey).
+		SetResult(&stages).
+		SetError(&projectError).
+		Get(LifecycleStagesUrl)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("%s", projectError.String())
+	}
+
+	return stages, nil
+}
+
+// readPromotionPath returns the ordered promotion stages of the project.
+var readPromotionPath = func(ctx context.Context, projectKey string, client *resty.Client) ([]string, error) {
+	tflog.Debug(ctx, "readPromotionPath")
+
+	var lifecycle LifecycleAPIModel
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetQueryParam("project_key", projectKey).
+		SetResult(&lifecycle).
+		SetError(&projectError).
+		Get(LifecycleUrl)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("%s", projectError.String())
+	}
+
+	promoteCategory, _ := lo.Find(lifecycle.Categories, func(c LifecycleCategoryAPIModel) bool {
+		return c.Category == lifecycleStageCategoryPromote
+	})
+
+	return lo.Map(promoteCategory.Stages, func(stage LifecycleStageAPIModel, _ int) string {
+		return stage.Name
+	}), nil
+}
+
+var updatePromotionPath = func(ctx context.Context, projectKey string, stages []string, client *resty.Client) error {
+	tflog.Debug(ctx, fmt.Sprintf("updatePromotionPath: %s", stages))
+
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetQueryParam("project_key", projectKey).
+		SetBody(LifecycleUpdateAPIModel{
+			PromoteStages: stages,
+		}).
+		SetError(&projectError).
+		Patch(LifecycleUrl)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("%s", projectError.String())
+	}
+
+	return nil
+}
+
+// checkPromotionPath verifies that the stages of the promotion path exist and are promotion stages.
+// The order of the stages is applied as configured and is not verified.
+func checkPromotionPath(stages []string, availableStages []LifecycleStageAPIModel) error {
+	for _, name := range stages {
+		stage, ok := lo.Find(availableStages, func(s LifecycleStageAPIModel) bool {
+			return s.Name == name
+		})
+		if !ok {
+			return fmt.Errorf("stage %s does not exist, available stages: %s", name, formatNames(lo.Map(availableStages, func(s LifecycleStageAPIModel, _ int) string {
+				return s.Name
+			})))
+		}
+
+		if stage.Category == lifecycleStageCategoryCode {
+			return fmt.Errorf("stage %s is a '%s' stage and cannot be part of the promotion path", name, lifecycleStageCategoryCode)
+		}
+	}
+
+	return nil
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
 )
 
 const projectMembershipsUrl = ProjectUrl + "/{membershipType}"
@@ -15,6 +18,9 @@ const projectMembershipUrl = projectMembershipsUrl + "/{memberName}"
 const usersMembershipType = "users"
 const groupsMembershipType = "groups"
 
+const groupUrl = "/access/api/v2/groups/{groupName}"
+const userUrl = "/access/api/v2/users/{userName}"
+
 // Use by both project user and project group, as they shared identical data structure
 type MemberAPIModel struct {
 	Name  string   `json:"name"`
@@ -34,6 +40,81 @@ type MembershipAPIModel struct {
 	Members []MemberAPIModel
 }
 
+// Platform group GET {{ host }}/access/api/v2/groups/{{groupName}}
+type GroupAPIModel struct {
+	Name    string   `json:"name"`
+	Members []string `json:"members"`
+}
+
+// groupExists reports whether the platform group exists, e.g. once it has been synced from an external identity provider
+var groupExists = func(ctx context.Context, groupName string, client *resty.Client) (bool, error) {
+	tflog.Debug(ctx, "groupExists")
+
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetPathParam("groupName", groupName).
+		SetError(&projectError).
+		Get(groupUrl)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.IsError() {
+		return false, fmt.Errorf("%s", projectError.String())
+	}
+
+	return true, nil
+}
+
+// userExists reports whether the platform user exists, e.g. once it has been provisioned by SCIM
+var userExists = func(ctx context.Context, userName string, client *resty.Client) (bool, error) {
+	tflog.Debug(ctx, "userExists")
+
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetPathParam("userName", userName).
+		SetError(&projectError).
+		Get(userUrl)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.IsError() {
+		return false, fmt.Errorf("%s", projectError.String())
+	}
+
+	return true, nil
+}
+
+var readGroupUsers = func(ctx context.Context, groupName string, client *resty.Client) ([]string, error) {
+	tflog.Debug(ctx, "readGroupUsers")
+
+	var group GroupAPIModel
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetPathParam("groupName", groupName).
+		SetResult(&group).
+		SetError(&projectError).
+		Get(groupUrl)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, fmt.Errorf("group '%s' not found", groupName)
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("%s", projectError.String())
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("readGroupUsers: %+v\n", group))
+
+	return group.Members, nil
+}
+
 var readMembers = func(ctx context.Context, projectKey, membershipType string, client *resty.Client) ([]MemberAPIModel, error) {
 	tflog.Debug(ctx, "readMembers")
 
@@ -77,14 +158,49 @@ var updateMembers = func(ctx context.Context, projectKey, membershipType string,
 	}
 	tflog.Trace(ctx, fmt.Sprintf("projectMembers: %+v\n", projectMembers))
 
+	membersToBeDeleted := SetFromSlice(projectMembers).Difference(SetFromSlice(members))
+
+	return applyMembers(ctx, projectKey, membershipType, members, projectMembers, membersToBeDeleted, client)
+}
+
+// updateListedMembers is the non-authoritative variant of updateMembers: only members previously
+// listed by Terraform are removed from the project, members added by other means are left untouched.
+var updateListedMembers = func(ctx context.Context, projectKey, membershipType string, members, previousMembers []MemberAPIModel, client *resty.Client) ([]MemberAPIModel, error) {
+	tflog.Debug(ctx, "updateListedMembers")
+	tflog.Trace(ctx, fmt.Sprintf("terraformMembership.Members: %+v\n", members))
+
+	if membershipType != usersMembershipType && membershipType != groupsMembershipType {
+		return nil, fmt.Errorf("invalid membershipType: %s", membershipType)
+	}
+
+	projectMembers, err := readMembers(ctx, projectKey, membershipType, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch memberships for project: %s", err)
+	}
+	tflog.Trace(ctx, fmt.Sprintf("projectMembers: %+v\n", projectMembers))
+
+	membersToBeDeleted := SetFromSlice(previousMembers).
+		Difference(SetFromSlice(members)).
+		Intersection(SetFromSlice(projectMembers))
+
+	return applyMembers(ctx, projectKey, membershipType, members, projectMembers, membersToBeDeleted, client)
+}
+
+func applyMembers(ctx context.Context, projectKey, membershipType string, members, projectMembers, membersToBeDeleted []MemberAPIModel, client *resty.Client) ([]MemberAPIModel, error) {
 	terraformMembersSet := SetFromSlice(members)
 	projectMembersSet := SetFromSlice(projectMembers)
 
 	membersToBeAdded := terraformMembersSet.Difference(projectMembersSet)
 	tflog.Trace(ctx, fmt.Sprintf("membersToBeAdded: %+v\n", membersToBeAdded))
-	membersToBeUpdated := terraformMembersSet.Intersection(projectMembersSet)
+
+	// Skip members whose roles are already up to date to avoid one PUT per unchanged member
+	projectMemberRoles := lo.SliceToMap(projectMembers, func(member MemberAPIModel) (string, []string) {
+		return member.Name, member.Roles
+	})
+	membersToBeUpdated := lo.Filter(terraformMembersSet.Intersection(projectMembersSet), func(member MemberAPIModel, _ int) bool {
+		return !lo.ElementsMatch(lo.Uniq(member.Roles), lo.Uniq(projectMemberRoles[member.Name]))
+	})
 	tflog.Trace(ctx, fmt.Sprintf("membersToBeUpdated: %+v\n", membersToBeUpdated))
-	membersToBeDeleted := projectMembersSet.Difference(terraformMembersSet)
 	tflog.Trace(ctx, fmt.Sprintf("membersToBeDeleted: %+v\n", membersToBeDeleted))
 
 	for _, member := range append(membersToBeAdded, membersToBeUpdated...) {
@@ -102,6 +218,38 @@ var updateMembers = func(ctx context.Context, projectKey, membershipType string,
 	return readMembers(ctx, projectKey, membershipType, client)
 }
 
+// diffMembers compares 2 lists of members and returns the names of the members only in current,
+// only in previous, and in both but with different roles.
+func diffMembers(previous, current []MemberAPIModel) (added, removed, changed []string) {
+	previousSet := SetFromSlice(previous)
+	currentSet := SetFromSlice(current)
+
+	toName := func(member MemberAPIModel, _ int) string { return member.Name }
+
+	added = lo.Map(currentSet.Difference(previousSet), toName)
+	removed = lo.Map(previousSet.Difference(currentSet), toName)
+
+	previousRoles := lo.SliceToMap(previous, func(member MemberAPIModel) (string, []string) {
+		return member.Name, member.Roles
+	})
+	changed = lo.FilterMap(currentSet.Intersection(previousSet), func(member MemberAPIModel, _ int) (string, bool) {
+		return member.Name, !lo.ElementsMatch(member.Roles, previousRoles[member.Name])
+	})
+
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(changed)
+
+	return
+}
+
+func formatNames(names []string) string {
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
 var updateMember = func(ctx context.Context, projectKey, membershipType string, member MemberAPIModel, client *resty.Client) error {
 	tflog.Debug(ctx, "updateMember")
 	tflog.Trace(ctx, fmt.Sprintf("member: %v", member))
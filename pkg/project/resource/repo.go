@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/samber/lo"
@@ -69,6 +71,102 @@ var updateRepos = func(ctx context.Context, projectKey string, terraformRepoKeys
 	return readRepos(ctx, projectKey, client)
 }
 
+// waitForRepos polls the list of repositories assigned to the project until all of the
+// given repo keys are assigned. Each attempt is a single list call regardless of the
+// number of repositories.
+var waitForRepos = func(ctx context.Context, projectKey string, repoKeys []string, client *resty.Client) ([]string, error) {
+	tflog.Debug(ctx, fmt.Sprintf("waitForRepos: %s", repoKeys))
+
+	var projectRepoKeys []string
+	var retryFunc = func() error {
+		var err error
+		projectRepoKeys, err = readRepos(ctx, projectKey, client)
+		if err != nil {
+			return fmt.Errorf("failed to fetch repos for project: %s", err)
+		}
+
+		pendingRepoKeys, _ := lo.Difference(repoKeys, projectRepoKeys)
+		if len(pendingRepoKeys) > 0 {
+			return fmt.Errorf("expected repositories to be assigned to project but currently not: %s", pendingRepoKeys)
+		}
+
+		return nil
+	}
+
+	bf := backoff.WithContext(
+		backoff.NewExponentialBackOff(backoff.WithMaxElapsedTime(20*time.Minute)),
+		ctx,
+	)
+	if err := backoff.Retry(retryFunc, bf); err != nil {
+		return nil, err
+	}
+
+	return projectRepoKeys, nil
+}
+
+type RepoEnvironmentsAPIModel struct {
+	Rclass       string   `json:"rclass"`
+	Environments []string `json:"environments"`
+}
+
+// updateRepoEnvironments assigns the repository to the given project environments, replacing the current ones.
+var updateRepoEnvironments = func(ctx context.Context, repoKey string, environments []string, client *resty.Client) error {
+	tflog.Debug(ctx, fmt.Sprintf("updateRepoEnvironments: %s", repoKey))
+
+	// rclass is required by the repository configuration update API
+	var repo RepoEnvironmentsAPIModel
+	resp, err := client.R().
+		SetPathParam("key", repoKey).
+		SetResult(&repo).
+		Get(repositoryEndpoint)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("failed to fetch repo %s: %s", repoKey, resp.String())
+	}
+
+	resp, err = client.R().
+		SetPathParam("key", repoKey).
+		SetBody(RepoEnvironmentsAPIModel{
+			Rclass:       repo.Rclass,
+			Environments: environments,
+		}).
+		Post(repositoryEndpoint)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("failed to update environments of repo %s: %s", repoKey, resp.String())
+	}
+
+	return nil
+}
+
+// waitForRepoAssignment polls the repository status until the repository is assigned to the project.
+var waitForRepoAssignment = func(ctx context.Context, repoKey, projectKey string, client *resty.Client) error {
+	tflog.Debug(ctx, fmt.Sprintf("waitForRepoAssignment: %s", repoKey))
+
+	var retryFunc = func() error {
+		status, err := readRepoStatus(ctx, repoKey, client)
+		if err != nil {
+			return fmt.Errorf("failed to fetch status for repo %s: %s", repoKey, err)
+		}
+
+		if status.AssignedTo != projectKey {
+			return fmt.Errorf("expected repository %s to be assigned to project %s but currently assigned to '%s'", repoKey, projectKey, status.AssignedTo)
+		}
+
+		return nil
+	}
+
+	bf := backoff.WithContext(
+		backoff.NewExponentialBackOff(backoff.WithMaxElapsedTime(20*time.Minute)),
+		ctx,
+	)
+	return backoff.Retry(retryFunc, bf)
+}
+
 var addRepos = func(ctx context.Context, projectKey string, repoKeys []string, client *resty.Client) error {
 	tflog.Debug(ctx, fmt.Sprintf("addRepos: %s", repoKeys))
 
@@ -155,3 +253,181 @@ var deleteRepo = func(ctx context.Context, repoKey string, req *resty.Request) e
 
 	return nil
 }
+
+var readRepoStatus = func(ctx context.Context, repoKey string, client *resty.Client) (*ProjectRepositoryStatusAPIModel, error) {
+	tflog.Debug(ctx, fmt.Sprintf("readRepoStatus: %s", repoKey))
+
+	var status ProjectRepositoryStatusAPIModel
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetPathParam("repo_key", repoKey).
+		SetResult(&status).
+		SetError(&projectError).
+		Get(ProjectRepositoryStatusEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("%s", projectError.String())
+	}
+
+	return &status, nil
+}
+
+// unshareRepo removes every share of the repository, either with all projects or with
+// specific target projects. Returns the keys of the projects the repository was shared with,
+// or "*" when it was shared with all projects.
+var unshareRepo = func(ctx context.Context, repoKey string, client *resty.Client) ([]string, error) {
+	tflog.Debug(ctx, fmt.Sprintf("unshareRepo: %s", repoKey))
+
+	status, err := readRepoStatus(ctx, repoKey, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch status for repo %s: %s", repoKey, err)
+	}
+
+	var unshared []string
+
+	if status.SharedWithAllProjects {
+		var projectError ProjectErrorsResponse
+		resp, err := client.R().
+			SetPathParam("repo_key", repoKey).
+			SetQueryParam("readOnly", fmt.Sprintf("%t", status.SharedReadOnly)).
+			SetError(&projectError).
+			Delete(shareWithAllProjectsEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		if resp.IsError() && resp.StatusCode() != http.StatusNotFound {
+			return nil, fmt.Errorf("failed to unshare repo %s with all projects: %s", repoKey, projectError.String())
+		}
+		unshared = append(unshared, "*")
+	}
+
+	for _, targetProjectKey := range status.SharedWithProjects {
+		if err := unshareRepoWithProject(ctx, repoKey, targetProjectKey, status.SharedReadOnly, client); err != nil {
+			return nil, err
+		}
+		unshared = append(unshared, targetProjectKey)
+	}
+
+	return unshared, nil
+}
+
+type RepoStorageAPIModel struct {
+	Repo     string `json:"repo"`
+	Children []struct {
+		URI    string `json:"uri"`
+		Folder bool   `json:"folder"`
+	} `json:"children"`
+}
+
+// repoHasArtifacts returns true if the root folder of the repository is not empty.
+var repoHasArtifacts = func(ctx context.Context, repoKey string, client *resty.Client) (bool, error) {
+	tflog.Debug(ctx, fmt.Sprintf("repoHasArtifacts: %s", repoKey))
+
+	var storage RepoStorageAPIModel
+	resp, err := client.R().
+		SetPathParam("repoKey", repoKey).
+		SetResult(&storage).
+		Get("/artifactory/api/storage/{repoKey}")
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.IsError() {
+		return false, fmt.Errorf("failed to fetch storage info for repo %s: %s", repoKey, resp.String())
+	}
+
+	return len(storage.Children) > 0, nil
+}
+
+// waitForShareReadOnly polls the repository status until the repository is shared with the target project,
+// or with all projects when targetProjectKey is empty, in the expected read-only mode. The status only reports
+// the read-only mode of the repository as a whole, so the mode is only verified when the share is the only
+// one of the repository, since other shares may use another mode.
+var waitForShareReadOnly = func(ctx context.Context, repoKey, targetProjectKey string, readOnly bool, client *resty.Client) error {
+	tflog.Debug(ctx, fmt.Sprintf("waitForShareReadOnly: %s, %s", repoKey, targetProjectKey))
+
+	var retryFunc = func() error {
+		status, err := readRepoStatus(ctx, repoKey, client)
+		if err != nil {
+			return fmt.Errorf("failed to fetch status for repo %s: %s", repoKey, err)
+		}
+
+		otherTargetProjectKeys := lo.Without(status.SharedWithProjects, targetProjectKey)
+		otherShares := len(otherTargetProjectKeys) > 0
+		if targetProjectKey == "" {
+			if !status.SharedWithAllProjects {
+				return fmt.Errorf("expected repository %s to be shared with all projects but currently not", repoKey)
+			}
+		} else {
+			if !lo.Contains(status.SharedWithProjects, targetProjectKey) {
+				return fmt.Errorf("expected repository %s to be shared with project %s but currently not", repoKey, targetProjectKey)
+			}
+			otherShares = otherShares || status.SharedWithAllProjects
+		}
+
+		if otherShares {
+			tflog.Debug(ctx, fmt.Sprintf("waitForShareReadOnly: %s has other shares, read-only mode not verified", repoKey))
+			return nil
+		}
+
+		if status.SharedReadOnly != readOnly {
+			return fmt.Errorf("expected repository %s to be shared with shared_read_only %t but currently %t", repoKey, readOnly, status.SharedReadOnly)
+		}
+
+		return nil
+	}
+
+	bf := backoff.WithContext(
+		backoff.NewExponentialBackOff(backoff.WithMaxElapsedTime(20*time.Minute)),
+		ctx,
+	)
+	return backoff.Retry(retryFunc, bf)
+}
+
+var shareRepoWithProject = func(ctx context.Context, repoKey, targetProjectKey string, readOnly bool, client *resty.Client) error {
+	tflog.Debug(ctx, fmt.Sprintf("shareRepoWithProject: %s, %s", repoKey, targetProjectKey))
+
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetPathParams(map[string]string{
+			"repo_key":           repoKey,
+			"target_project_key": targetProjectKey,
+		}).
+		SetQueryParam("readOnly", fmt.Sprintf("%t", readOnly)).
+		SetError(&projectError).
+		Put(shareWithTargetProject)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("failed to share repo %s with project %s: %s", repoKey, targetProjectKey, projectError.String())
+	}
+
+	return nil
+}
+
+var unshareRepoWithProject = func(ctx context.Context, repoKey, targetProjectKey string, readOnly bool, client *resty.Client) error {
+	tflog.Debug(ctx, fmt.Sprintf("unshareRepoWithProject: %s, %s", repoKey, targetProjectKey))
+
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetPathParams(map[string]string{
+			"repo_key":           repoKey,
+			"target_project_key": targetProjectKey,
+		}).
+		SetQueryParam("readOnly", fmt.Sprintf("%t", readOnly)).
+		SetError(&projectError).
+		Delete(shareWithTargetProject)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() && resp.StatusCode() != http.StatusNotFound {
+		return fmt.Errorf("failed to unshare repo %s with project %s: %s", repoKey, targetProjectKey, projectError.String())
+	}
+
+	return nil
+}
@@ -2,10 +2,12 @@ package project
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"net/http"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/go-resty/resty/v2"
@@ -18,11 +20,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
@@ -98,22 +104,29 @@ type ProjectResourceModelV3 struct {
 }
 
 type ProjectResourceModelV4 struct {
-	ID                           types.String `tfsdk:"id"`
-	Key                          types.String `tfsdk:"key"`
-	DisplayName                  types.String `tfsdk:"display_name"`
-	Description                  types.String `tfsdk:"description"`
-	AdminPrivileges              types.Set    `tfsdk:"admin_privileges"`
-	MaxStorageInGibibytes        types.Int64  `tfsdk:"max_storage_in_gibibytes"`
-	SoftLimit                    types.Bool   `tfsdk:"block_deployments_on_limit"`
-	QuotaEmailNotification       types.Bool   `tfsdk:"email_notification"`
-	Members                      types.Set    `tfsdk:"member"`
-	Groups                       types.Set    `tfsdk:"group"`
-	Roles                        types.Set    `tfsdk:"role"`
-	Repos                        types.Set    `tfsdk:"repos"`
-	UseProjectRoleResource       types.Bool   `tfsdk:"use_project_role_resource"`
-	UseProjectUserResource       types.Bool   `tfsdk:"use_project_user_resource"`
-	UseProjectGroupResource      types.Bool   `tfsdk:"use_project_group_resource"`
-	UseProjectRepositoryResource types.Bool   `tfsdk:"use_project_repository_resource"`
+	ID                           types.String  `tfsdk:"id"`
+	Key                          types.String  `tfsdk:"key"`
+	DisplayName                  types.String  `tfsdk:"display_name"`
+	Description                  types.String  `tfsdk:"description"`
+	AdminPrivileges              types.Set     `tfsdk:"admin_privileges"`
+	MaxStorageInGibibytes        types.Int64   `tfsdk:"max_storage_in_gibibytes"`
+	SoftLimit                    types.Bool    `tfsdk:"block_deployments_on_limit"`
+	QuotaEmailNotification       types.Bool    `tfsdk:"email_notification"`
+	Members                      types.Set     `tfsdk:"member"`
+	Groups                       types.Set     `tfsdk:"group"`
+	Roles                        types.Set     `tfsdk:"role"`
+	Repos                        types.Set     `tfsdk:"repos"`
+	UseProjectRoleResource       types.Bool    `tfsdk:"use_project_role_resource"`
+	UseProjectUserResource       types.Bool    `tfsdk:"use_project_user_resource"`
+	UseProjectGroupResource      types.Bool    `tfsdk:"use_project_group_resource"`
+	UseProjectRepositoryResource types.Bool    `tfsdk:"use_project_repository_resource"`
+	ForceDestroy                 types.Bool    `tfsdk:"force_destroy"`
+	DeletionProtection           types.Bool    `tfsdk:"deletion_protection"`
+	TrackStorageUsage            types.Bool    `tfsdk:"track_storage_usage"`
+	UsedStorageBytes             types.Int64   `tfsdk:"used_storage_bytes"`
+	UsedStoragePercent           types.Float64 `tfsdk:"used_storage_percent"`
+	QuotaExceeded                types.Bool    `tfsdk:"quota_exceeded"`
+	MaxStorage                   types.String  `tfsdk:"max_storage"`
 }
 
 var adminPrivilegesAttrType = map[string]attr.Type{
@@ -187,6 +200,14 @@ func (r *ProjectResourceModelV4) fromAPIModel(ctx context.Context, apiModel Proj
 	}
 
 	r.MaxStorageInGibibytes = types.Int64Value(BytesToGibibytes(apiModel.StorageQuota))
+	if !r.MaxStorage.IsNull() {
+		// keep the configured format unless the quota was changed outside of Terraform
+		if apiModel.StorageQuota <= 0 {
+			r.MaxStorage = types.StringNull()
+		} else if configuredBytes, err := parseStorageSize(r.MaxStorage.ValueString()); err != nil || configuredBytes != apiModel.StorageQuota {
+			r.MaxStorage = types.StringValue(formatStorageSize(apiModel.StorageQuota))
+		}
+	}
 	r.SoftLimit = types.BoolValue(!apiModel.SoftLimit)
 	r.QuotaEmailNotification = types.BoolValue(apiModel.QuotaEmailNotification)
 
@@ -313,6 +334,18 @@ func resourceMemberToAPIModels(ctx context.Context, members types.Set) ([]Member
 	return ms, ds
 }
 
+// storageQuotaBytes returns the storage quota in bytes, from `max_storage` when set
+// or from `max_storage_in_gibibytes` otherwise.
+func (r ProjectResourceModelV4) storageQuotaBytes() int64 {
+	if !r.MaxStorage.IsNull() && !r.MaxStorage.IsUnknown() {
+		if bytes, err := parseStorageSize(r.MaxStorage.ValueString()); err == nil {
+			return bytes
+		}
+	}
+
+	return GibibytesToBytes(r.MaxStorageInGibibytes.ValueInt64())
+}
+
 func (r ProjectResourceModelV4) toAPIModel(ctx context.Context, project *ProjectAPIModel, users, groups *[]MemberAPIModel, roles *[]Role, repos *[]string) diag.Diagnostics {
 	ds := diag.Diagnostics{}
 
@@ -320,7 +353,7 @@ func (r ProjectResourceModelV4) toAPIModel(ctx context.Context, project *Project
 		Key:                    r.Key.ValueString(),
 		DisplayName:            r.DisplayName.ValueString(),
 		Description:            r.Description.ValueString(),
-		StorageQuota:           GibibytesToBytes(r.MaxStorageInGibibytes.ValueInt64()),
+		StorageQuota:           r.storageQuotaBytes(),
 		SoftLimit:              !r.SoftLimit.ValueBool(),
 		QuotaEmailNotification: r.QuotaEmailNotification.ValueBool(),
 	}
@@ -510,7 +543,7 @@ var schemaV1 = schema.Schema{
 					"roles": schema.SetAttribute{
 						ElementType: types.StringType,
 						Required:    true,
-						Description: "List of pre-defined Project or custom roles",
+						Description: "List of pre-defined Project or custom roles. Every role must exist in the project or be declared in a `role` block; unknown roles are reported at plan time when the project already exists. With `use_project_role_resource` set to true, they are only reported as a warning, since `project_role` resources may create them in the same apply.",
 					},
 				},
 			},
@@ -529,7 +562,7 @@ var schemaV1 = schema.Schema{
 					"roles": schema.SetAttribute{
 						ElementType: types.StringType,
 						Required:    true,
-						Description: "List of pre-defined Project or custom roles",
+						Description: "List of pre-defined Project or custom roles. Every role must exist in the project or be declared in a `role` block; unknown roles are reported at plan time when the project already exists. With `use_project_role_resource` set to true, they are only reported as a warning, since `project_role` resources may create them in the same apply.",
 					},
 				},
 			},
@@ -557,7 +590,7 @@ var schemaV1 = schema.Schema{
 					"environments": schema.SetAttribute{
 						ElementType: types.StringType,
 						Required:    true,
-						Description: fmt.Sprintf("A repository can be available in different environments. Members with roles defined in the set environment will have access to the repository. List of pre-defined environments (%s)", strings.Join(validRoleEnvironments, ", ")),
+						Description: fmt.Sprintf("A repository can be available in different environments. Members with roles defined in the set environment will have access to the repository. List of pre-defined environments (%s), or the name of a global environment (`project_global_environment`) or project environment (`project_environment`).", strings.Join(validRoleEnvironments, ", ")),
 					},
 					"actions": schema.SetAttribute{
 						ElementType: types.StringType,
@@ -605,7 +638,7 @@ var schemaV2 = schema.Schema{
 					"environments": schema.SetAttribute{
 						ElementType: types.StringType,
 						Required:    true,
-						Description: fmt.Sprintf("A repository can be available in different environments. Members with roles defined in the set environment will have access to the repository. List of pre-defined environments (%s)", strings.Join(validRoleEnvironments, ", ")),
+						Description: fmt.Sprintf("A repository can be available in different environments. Members with roles defined in the set environment will have access to the repository. List of pre-defined environments (%s), or the name of a global environment (`project_global_environment`) or project environment (`project_environment`).", strings.Join(validRoleEnvironments, ", ")),
 					},
 					"actions": schema.SetAttribute{
 						ElementType: types.StringType,
@@ -701,9 +734,56 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Description:        "(Optional) List of existing repo keys to be assigned to the project. If you wish to use the alternate method of setting `project_key` attribute in each `artifactory_*_repository` resource in the `artifactory` provider, you will need to use `lifecycle.ignore_changes` in the `project` resource to avoid state drift.\n\n```hcl\nlifecycle {\n\tignore_changes = [\n\t\trepos\n\t]\n}\n```",
 				DeprecationMessage: "Replaced by `project_repository` resource. This should not be used in combination with `project_repository` resource. Use `use_project_repository_resource` attribute to control which resource manages project repositories.",
 			},
+			"force_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true`, destroying the project first detaches every repository assigned to it, including the ones not managed by Terraform, removes their shares with other projects, and deletes the environments that belong to the project. Global environments are left as is. What was removed is reported as a warning. Default to `false`.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true`, any plan that destroys or replaces the project (e.g. a change of `key`) fails until this attribute is set to `false` and applied. Default to `false`.",
+			},
+			"max_storage": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					storageSizeValidator{},
+					stringvalidator.ConflictsWith(path.MatchRoot("max_storage_in_gibibytes")),
+				},
+				Description: "Storage quota as a number of bytes (e.g. `1073741824`) or a size with a binary unit: `B`, `KiB`, `MiB`, `GiB`, `TiB` or `PiB` (e.g. `500GiB`, `1.5TiB`). The value must amount to a whole number of bytes and is sent to the API as is, so quotas that are not a whole number of GiB do not drift. Conflicts with `max_storage_in_gibibytes`, which is then computed from this value (rounded down).",
+			},
+			"track_storage_usage": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true`, `used_storage_bytes`, `used_storage_percent`, and `quota_exceeded` are read on every refresh from the platform's storage summary, which requires admin permissions and lists the storage of every repository. Default to `false`.",
+			},
+			"used_storage_bytes": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Storage used by the repositories assigned to the project, in bytes, from the platform's storage summary. The storage summary is calculated periodically by Artifactory, so this value may lag behind recent uploads. Null unless `track_storage_usage` is `true`, or when the storage summary cannot be read.",
+			},
+			"used_storage_percent": schema.Float64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+				Description: "Percentage of `max_storage_in_gibibytes` used by the repositories assigned to the project. `0` when the storage is unlimited. Null when `used_storage_bytes` is null.",
+			},
+			"quota_exceeded": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+				Description: "`true` when the storage used by the repositories assigned to the project reached `max_storage_in_gibibytes`. Null when `used_storage_bytes` is null.",
+			},
 		}),
 		Blocks:      schemaV3.Blocks,
-		Description: "Provides an Artifactory project resource. This can be used to create and manage Artifactory project, maintain users/groups/roles/repos.\n\n## Repository Configuration\n\nAfter the project configuration is applied with `repos` attribute set, the repository's attributes `project_key` and `project_environments` would be updated with the project's data. This will generate a state drift in the next Terraform plan/apply for the repository resource. To avoid this, apply `lifecycle.ignore_changes`:\n\n```hcl\nresource \"artifactory_local_maven_repository\" \"my_maven_releases\" {\n\tkey = \"my-maven-releases\"\n\t...\n\n\tlifecycle {\n\t\tignore_changes = [\n\t\t\tproject_environments,\n\t\t\tproject_key\n\t\t]\n\t}\n}\n```\n\n~>We strongly recommend using the `project_repository` resource instead to manage the list of repositories.",
+		Description: "Provides an Artifactory project resource. This can be used to create and manage Artifactory project, maintain users/groups/roles/repos.\n\n## Repository Configuration\n\nAfter the project configuration is applied with `repos` attribute set, the repository's attributes `project_key` and `project_environments` would be updated with the project's data. This will generate a state drift in the next Terraform plan/apply for the repository resource. To avoid this, apply `lifecycle.ignore_changes`:\n\n```hcl\nresource \"artifactory_local_maven_repository\" \"my_maven_releases\" {\n\tkey = \"my-maven-releases\"\n\t...\n\n\tlifecycle {\n\t\tignore_changes = [\n\t\t\tproject_environments,\n\t\t\tproject_key\n\t\t]\n\t}\n}\n```\n\n~>We strongly recommend using the `project_repository` resource instead to manage the list of repositories.\n\n~>Managing the same kind of sub-resources both with a nested attribute (`use_project_*_resource = false`) and with standalone resources is reported at plan time only when both are in the same configuration. Conflicts across configurations or workspaces are not detected.",
 	}
 }
 
@@ -764,6 +844,14 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	if !plan.UseProjectUserResource.ValueBool() {
+		// Roles managed by `project_role` resources may not be created yet
+		if !plan.UseProjectRoleResource.ValueBool() {
+			if err := checkRolesExist(ctx, project.Key, memberRoles(users), nil, r.ProviderData.Client); err != nil {
+				utilfw.UnableToCreateResourceError(resp, err.Error())
+				return
+			}
+		}
+
 		_, err = updateMembers(ctx, project.Key, usersMembershipType, users, r.ProviderData.Client)
 		if err != nil {
 			utilfw.UnableToCreateResourceError(resp, err.Error())
@@ -772,6 +860,14 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	if !plan.UseProjectGroupResource.ValueBool() {
+		// Roles managed by `project_role` resources may not be created yet
+		if !plan.UseProjectRoleResource.ValueBool() {
+			if err := checkRolesExist(ctx, project.Key, memberRoles(groups), nil, r.ProviderData.Client); err != nil {
+				utilfw.UnableToCreateResourceError(resp, err.Error())
+				return
+			}
+		}
+
 		_, err = updateMembers(ctx, project.Key, groupsMembershipType, groups, r.ProviderData.Client)
 		if err != nil {
 			utilfw.UnableToCreateResourceError(resp, err.Error())
@@ -787,6 +883,8 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 	}
 
+	resp.Diagnostics.Append(r.refreshStorageUsage(ctx, &plan, false, resp.Private)...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -864,6 +962,19 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	// Attribute added without a schema version bump, default it for existing state and import
+	if state.ForceDestroy.IsNull() {
+		state.ForceDestroy = types.BoolValue(false)
+	}
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+	if state.TrackStorageUsage.IsNull() {
+		state.TrackStorageUsage = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(r.refreshStorageUsage(ctx, &state, true, resp.Private)...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -918,6 +1029,14 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	if !plan.UseProjectUserResource.ValueBool() {
+		// Roles managed by `project_role` resources may not be created yet
+		if !plan.UseProjectRoleResource.ValueBool() {
+			if err := checkRolesExist(ctx, project.Key, memberRoles(users), nil, r.ProviderData.Client); err != nil {
+				utilfw.UnableToUpdateResourceError(resp, err.Error())
+				return
+			}
+		}
+
 		_, err = updateMembers(ctx, project.Key, usersMembershipType, users, r.ProviderData.Client)
 		if err != nil {
 			utilfw.UnableToUpdateResourceError(resp, err.Error())
@@ -926,6 +1045,14 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	if !plan.UseProjectGroupResource.ValueBool() {
+		// Roles managed by `project_role` resources may not be created yet
+		if !plan.UseProjectRoleResource.ValueBool() {
+			if err := checkRolesExist(ctx, project.Key, memberRoles(groups), nil, r.ProviderData.Client); err != nil {
+				utilfw.UnableToUpdateResourceError(resp, err.Error())
+				return
+			}
+		}
+
 		_, err = updateMembers(ctx, project.Key, groupsMembershipType, groups, r.ProviderData.Client)
 		if err != nil {
 			utilfw.UnableToUpdateResourceError(resp, err.Error())
@@ -941,6 +1068,8 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
+	resp.Diagnostics.Append(r.refreshStorageUsage(ctx, &plan, false, resp.Private)...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -960,16 +1089,28 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	var repos []string
-	resp.Diagnostics.Append(state.Repos.ElementsAs(ctx, &repos, false)...)
-	if resp.Diagnostics.HasError() {
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionError(state.Key.ValueString()))
 		return
 	}
 
-	deleteErr := deleteRepos(ctx, repos, r.ProviderData.Client)
-	if deleteErr != nil {
-		utilfw.UnableToDeleteResourceError(resp, fmt.Sprintf("failed to delete repos for project: %s", deleteErr))
-		return
+	if state.ForceDestroy.ValueBool() {
+		resp.Diagnostics.Append(r.removeProjectResources(ctx, state.Key.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		var repos []string
+		resp.Diagnostics.Append(state.Repos.ElementsAs(ctx, &repos, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		deleteErr := deleteRepos(ctx, repos, r.ProviderData.Client)
+		if deleteErr != nil {
+			utilfw.UnableToDeleteResourceError(resp, fmt.Sprintf("failed to delete repos for project: %s", deleteErr))
+			return
+		}
 	}
 
 	var projectError ProjectErrorsResponse
@@ -996,6 +1137,445 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 	// the resource from state if there are no other errors.
 }
 
+type privateStateStore interface {
+	privateStateSetter
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// refreshStorageUsage sets the storage usage attributes when `track_storage_usage` is enabled, and clears
+// them otherwise. The used storage is only fetched when forced (on refresh) or unknown (on create, or when
+// tracking is turned on), so that an apply does not diverge from the plan. When it cannot be read, the usage
+// is left null rather than 0, and the warning is reported once until it can be read again.
+func (r *ProjectResource) refreshStorageUsage(ctx context.Context, model *ProjectResourceModelV4, force bool, private privateStateStore) diag.Diagnostics {
+	ds := diag.Diagnostics{}
+
+	if !model.TrackStorageUsage.ValueBool() {
+		model.UsedStorageBytes = types.Int64Null()
+		model.UsedStoragePercent = types.Float64Null()
+		model.QuotaExceeded = types.BoolNull()
+		return ds
+	}
+
+	if force || model.UsedStorageBytes.IsUnknown() {
+		usedBytes, err := readUsedStorage(ctx, model.Key.ValueString(), r.ProviderData.Client)
+		if err != nil {
+			model.UsedStorageBytes = types.Int64Null()
+
+			warned, d := private.GetKey(ctx, storageUsageWarnedPrivateStateKey)
+			ds.Append(d...)
+			if warned == nil {
+				// storage summary requires admin permissions, which a project admin may not have
+				ds.AddWarning(
+					"Unable to read storage usage",
+					fmt.Sprintf("%s\n\nThe storage usage attributes are left unset until the storage summary can be read. This warning is not repeated on the next refreshes.", err),
+				)
+				ds.Append(private.SetKey(ctx, storageUsageWarnedPrivateStateKey, []byte("true"))...)
+			}
+		} else {
+			model.UsedStorageBytes = types.Int64Value(usedBytes)
+			ds.Append(private.SetKey(ctx, storageUsageWarnedPrivateStateKey, nil)...)
+		}
+	}
+
+	if model.UsedStorageBytes.IsNull() {
+		model.UsedStoragePercent = types.Float64Null()
+		model.QuotaExceeded = types.BoolNull()
+		return ds
+	}
+
+	percent, exceeded := storageUsage(model.UsedStorageBytes.ValueInt64(), model.storageQuotaBytes())
+	model.UsedStoragePercent = types.Float64Value(percent)
+	model.QuotaExceeded = types.BoolValue(exceeded)
+
+	return ds
+}
+
+func deletionProtectionError(projectKey string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Project is protected from deletion",
+		fmt.Sprintf("Project '%s' has `deletion_protection` enabled. To destroy or replace it, first set `deletion_protection = false` and apply.", projectKey),
+	)
+}
+
+// removeProjectResources removes everything that prevents the project from being deleted:
+// shares of its repositories, the repositories assignment, and its environments.
+func (r *ProjectResource) removeProjectResources(ctx context.Context, projectKey string) diag.Diagnostics {
+	ds := diag.Diagnostics{}
+
+	repoKeys, err := readRepos(ctx, projectKey, r.ProviderData.Client)
+	if err != nil {
+		ds.AddError("Unable to Delete Resource", fmt.Sprintf("failed to fetch repos for project: %s", err))
+		return ds
+	}
+
+	var unsharedRepos []string
+	for _, repoKey := range repoKeys {
+		targetProjectKeys, err := unshareRepo(ctx, repoKey, r.ProviderData.Client)
+		if err != nil {
+			ds.AddError("Unable to Delete Resource", err.Error())
+			return ds
+		}
+		if len(targetProjectKeys) > 0 {
+			unsharedRepos = append(unsharedRepos, fmt.Sprintf("%s (%s)", repoKey, strings.Join(targetProjectKeys, ", ")))
+		}
+	}
+
+	if err := deleteRepos(ctx, repoKeys, r.ProviderData.Client); err != nil {
+		ds.AddError("Unable to Delete Resource", fmt.Sprintf("failed to delete repos for project: %s", err))
+		return ds
+	}
+
+	environments, err := readEnvironments(ctx, projectKey, r.ProviderData.Client)
+	if err != nil {
+		ds.AddError("Unable to Delete Resource", fmt.Sprintf("failed to fetch environments for project: %s", err))
+		return ds
+	}
+
+	for _, environment := range environments {
+		if err := deleteEnvironment(ctx, projectKey, environment, r.ProviderData.Client); err != nil {
+			ds.AddError("Unable to Delete Resource", fmt.Sprintf("failed to delete environment %s: %s", environment, err))
+			return ds
+		}
+	}
+
+	if len(repoKeys) > 0 || len(environments) > 0 {
+		ds.AddWarning(
+			"Project resources removed by force_destroy",
+			fmt.Sprintf(
+				"The following were removed from project '%s' before deleting it.\n\nRepositories detached: %s\nRepository shares removed: %s\nEnvironments deleted: %s",
+				projectKey, formatNames(repoKeys), formatNames(unsharedRepos), formatNames(environments),
+			),
+		)
+	}
+
+	return ds
+}
+
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the project is being destroyed
+	if req.Plan.Raw.IsNull() {
+		var state ProjectResourceModelV4
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.DeletionProtection.ValueBool() {
+			resp.Diagnostics.Append(deletionProtectionError(state.Key.ValueString()))
+			return
+		}
+
+		for _, management := range []managementType{usersManagement, groupsManagement, rolesManagement, repositoriesManagement} {
+			managementRegistryFor(r.ProviderData.Client).ReleaseNested(state.Key.ValueString(), management.Kind)
+		}
+		return
+	}
+
+	var plan ProjectResourceModelV4
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *ProjectResourceModelV4
+	if !req.State.Raw.IsNull() {
+		state = &ProjectResourceModelV4{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// A change of key replaces the project. The value in state is used so that protection
+	// must be turned off in a separate apply before the replacement.
+	if state != nil && state.DeletionProtection.ValueBool() && !plan.Key.Equal(state.Key) {
+		resp.Diagnostics.Append(deletionProtectionError(state.Key.ValueString()))
+		return
+	}
+
+	// When the quota is set with `max_storage`, `max_storage_in_gibibytes` is derived from it
+	// instead of the -1 default
+	var configMaxStorageInGibibytes types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max_storage_in_gibibytes"), &configMaxStorageInGibibytes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.MaxStorage.IsNull() && configMaxStorageInGibibytes.IsNull() {
+		if plan.MaxStorage.IsUnknown() {
+			plan.MaxStorageInGibibytes = types.Int64Unknown()
+		} else {
+			plan.MaxStorageInGibibytes = types.Int64Value(BytesToGibibytes(plan.storageQuotaBytes()))
+		}
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+
+	// The storage usage is read, or cleared, when tracking is turned on or off
+	if state != nil && !plan.TrackStorageUsage.Equal(state.TrackStorageUsage) {
+		plan.UsedStorageBytes = types.Int64Unknown()
+		plan.UsedStoragePercent = types.Float64Unknown()
+		plan.QuotaExceeded = types.BoolUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+
+	if state != nil && (!plan.MaxStorageInGibibytes.Equal(state.MaxStorageInGibibytes) || !plan.MaxStorage.Equal(state.MaxStorage)) {
+		// usage percentage and quota status are recalculated against the new quota
+		plan.UsedStoragePercent = types.Float64Unknown()
+		plan.QuotaExceeded = types.BoolUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+
+		// The usage is only known when tracked and readable
+		newQuotaBytes := plan.storageQuotaBytes()
+		if !plan.MaxStorageInGibibytes.IsUnknown() && !plan.MaxStorage.IsUnknown() && newQuotaBytes > 0 &&
+			!state.UsedStorageBytes.IsNull() && state.UsedStorageBytes.ValueInt64() > newQuotaBytes {
+			quotaAttribute := path.Root("max_storage_in_gibibytes")
+			if !plan.MaxStorage.IsNull() {
+				quotaAttribute = path.Root("max_storage")
+			}
+
+			resp.Diagnostics.AddAttributeError(
+				quotaAttribute,
+				"Storage quota lower than current usage",
+				fmt.Sprintf(
+					"Project '%s' currently uses %d bytes, which exceeds the new quota of %s (%d bytes). Free up storage first, or choose a quota of at least %d bytes.",
+					state.Key.ValueString(), state.UsedStorageBytes.ValueInt64(), formatStorageSize(newQuotaBytes), newQuotaBytes,
+					state.UsedStorageBytes.ValueInt64(),
+				),
+			)
+			return
+		}
+	}
+
+	// A replaced project no longer holds the claims of its previous key
+	if state != nil && !plan.Key.Equal(state.Key) {
+		for _, management := range []managementType{usersManagement, groupsManagement, rolesManagement, repositoriesManagement} {
+			managementRegistryFor(r.ProviderData.Client).ReleaseNested(state.Key.ValueString(), management.Kind)
+		}
+	}
+
+	if plan.Key.IsUnknown() {
+		return
+	}
+
+	projectKey := plan.Key.ValueString()
+
+	nestedManagements := []struct {
+		management   managementType
+		useResource  types.Bool
+		planItems    types.Set
+		stateItems   func(*ProjectResourceModelV4) types.Set
+		readAPIItems func() ([]string, error)
+	}{
+		{
+			management:  usersManagement,
+			useResource: plan.UseProjectUserResource,
+			planItems:   plan.Members,
+			stateItems:  func(m *ProjectResourceModelV4) types.Set { return m.Members },
+			readAPIItems: func() ([]string, error) {
+				members, err := readMembers(ctx, projectKey, usersMembershipType, r.ProviderData.Client)
+				return lo.Map(members, func(m MemberAPIModel, _ int) string { return m.Name }), err
+			},
+		},
+		{
+			management:  groupsManagement,
+			useResource: plan.UseProjectGroupResource,
+			planItems:   plan.Groups,
+			stateItems:  func(m *ProjectResourceModelV4) types.Set { return m.Groups },
+			readAPIItems: func() ([]string, error) {
+				members, err := readMembers(ctx, projectKey, groupsMembershipType, r.ProviderData.Client)
+				return lo.Map(members, func(m MemberAPIModel, _ int) string { return m.Name }), err
+			},
+		},
+		{
+			management:  rolesManagement,
+			useResource: plan.UseProjectRoleResource,
+			planItems:   plan.Roles,
+			stateItems:  func(m *ProjectResourceModelV4) types.Set { return m.Roles },
+			readAPIItems: func() ([]string, error) {
+				roles, err := readRoles(ctx, projectKey, r.ProviderData.Client)
+				return lo.Map(roles, func(role Role, _ int) string { return role.Name }), err
+			},
+		},
+		{
+			management:  repositoriesManagement,
+			useResource: plan.UseProjectRepositoryResource,
+			planItems:   plan.Repos,
+			stateItems:  func(m *ProjectResourceModelV4) types.Set { return m.Repos },
+			readAPIItems: func() ([]string, error) {
+				return readRepos(ctx, projectKey, r.ProviderData.Client)
+			},
+		},
+	}
+
+	for _, nested := range nestedManagements {
+		if nested.useResource.IsUnknown() {
+			continue
+		}
+
+		if nested.useResource.ValueBool() {
+			managementRegistryFor(r.ProviderData.Client).ReleaseNested(projectKey, nested.management.Kind)
+			continue
+		}
+
+		standaloneNames := managementRegistryFor(r.ProviderData.Client).ClaimNested(projectKey, nested.management.Kind)
+		if len(standaloneNames) > 0 {
+			resp.Diagnostics.Append(overlappingManagementError(projectKey, nested.management, standaloneNames))
+			continue
+		}
+
+		// Only existing projects can have members, roles or repositories added out-of-band, and
+		// they are only removed when the project is updated, so unchanged projects skip the API calls
+		if state == nil || r.ProviderData.Client == nil || req.Plan.Raw.Equal(req.State.Raw) {
+			continue
+		}
+
+		planNames, ok := nestedItemNames(nested.planItems)
+		if !ok {
+			continue
+		}
+		stateNames, ok := nestedItemNames(nested.stateItems(state))
+		if !ok {
+			continue
+		}
+
+		apiNames, err := nested.readAPIItems()
+		if err != nil {
+			tflog.Warn(ctx, "failed to read project for conflict detection", map[string]any{
+				"projectKey": projectKey,
+				"kind":       nested.management.Kind,
+				"err":        err,
+			})
+			continue
+		}
+
+		unmanagedNames, _ := lo.Difference(apiNames, append(planNames, stateNames...))
+		if len(unmanagedNames) > 0 {
+			sort.Strings(unmanagedNames)
+			resp.Diagnostics.Append(unmanagedRemovalWarning(projectKey, nested.management, unmanagedNames))
+		}
+	}
+
+	// The roles of the `member` and `group` blocks can only be verified once the project exists,
+	// and only need to be when the project is updated
+	if state == nil || r.ProviderData.Client == nil || req.Plan.Raw.Equal(req.State.Raw) || plan.UseProjectRoleResource.IsUnknown() {
+		return
+	}
+
+	// The custom roles of the `role` blocks are created before the members are assigned
+	rolesFromConfig := !plan.UseProjectRoleResource.ValueBool()
+	plannedRoles := []string{}
+	if rolesFromConfig {
+		names, ok := nestedItemNames(plan.Roles)
+		if !ok {
+			return
+		}
+		plannedRoles = names
+	}
+
+	for _, members := range []struct {
+		attribute   string
+		useResource types.Bool
+		planItems   types.Set
+	}{
+		{attribute: "member", useResource: plan.UseProjectUserResource, planItems: plan.Members},
+		{attribute: "group", useResource: plan.UseProjectGroupResource, planItems: plan.Groups},
+	} {
+		if members.useResource.IsUnknown() || members.useResource.ValueBool() {
+			continue
+		}
+
+		roles, ok := nestedMemberRoles(members.planItems)
+		if !ok {
+			continue
+		}
+
+		err := checkRolesExist(ctx, projectKey, roles, plannedRoles, r.ProviderData.Client)
+		if errors.Is(err, errProjectNotFound) {
+			continue
+		}
+		if err != nil {
+			// Roles managed by `project_role` resources may be created in the same apply
+			if rolesFromConfig {
+				resp.Diagnostics.AddAttributeError(path.Root(members.attribute), "Invalid project role", err.Error())
+			} else {
+				resp.Diagnostics.AddAttributeWarning(path.Root(members.attribute), "Invalid project role", err.Error())
+			}
+		}
+	}
+}
+
+// nestedMemberRoles returns the roles of the items of a `member` or `group` set.
+// Returns false if the set or any of its roles is not yet known.
+func nestedMemberRoles(items types.Set) ([]string, bool) {
+	if items.IsUnknown() {
+		return nil, false
+	}
+
+	roles := []string{}
+	for _, elem := range items.Elements() {
+		member, ok := elem.(types.Object)
+		if !ok || member.IsUnknown() {
+			return nil, false
+		}
+
+		memberRoles, ok := member.Attributes()["roles"].(types.Set)
+		if !ok || memberRoles.IsUnknown() {
+			return nil, false
+		}
+
+		for _, role := range memberRoles.Elements() {
+			name, ok := role.(types.String)
+			if !ok || name.IsUnknown() {
+				return nil, false
+			}
+			roles = append(roles, name.ValueString())
+		}
+	}
+
+	return roles, true
+}
+
+// memberRoles returns the roles of the members.
+func memberRoles(members []MemberAPIModel) []string {
+	return lo.FlatMap(members, func(member MemberAPIModel, _ int) []string {
+		return member.Roles
+	})
+}
+
+// nestedItemNames returns the names of the items of a `member`, `group`, `role` or `repos` set.
+// Returns false if the set or any of its names is not yet known.
+func nestedItemNames(items types.Set) ([]string, bool) {
+	if items.IsUnknown() {
+		return nil, false
+	}
+
+	names := []string{}
+	for _, elem := range items.Elements() {
+		var name types.String
+		switch v := elem.(type) {
+		case types.String:
+			name = v
+		case types.Object:
+			if v.IsUnknown() {
+				return nil, false
+			}
+			n, ok := v.Attributes()["name"].(types.String)
+			if !ok {
+				return nil, false
+			}
+			name = n
+		default:
+			return nil, false
+		}
+
+		if name.IsUnknown() {
+			return nil, false
+		}
+		names = append(names, name.ValueString())
+	}
+
+	return names, true
+}
+
 // ImportState imports the resource into the Terraform state.
 func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
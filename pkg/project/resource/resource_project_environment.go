@@ -2,11 +2,13 @@ package project
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,7 +22,15 @@ import (
 	"github.com/samber/lo"
 )
 
-const ProjectEnvironmentUrl = "/access/api/v1/projects/{projectKey}/environments"
+const (
+	ProjectEnvironmentUrl = "/access/api/v1/projects/{projectKey}/environments"
+
+	// environmentsPrivateStateKey stores the project environments seen at the last refresh,
+	// used to find the new name of an environment renamed outside Terraform.
+	environmentsPrivateStateKey = "project_environments"
+)
+
+var environmentNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`)
 
 func NewProjectEnvironmentResource() resource.Resource {
 	return &ProjectEnvironmentResource{
@@ -37,6 +47,35 @@ type ProjectEnvironmentResourceModel struct {
 	ID         types.String `tfsdk:"id"`
 	Name       types.String `tfsdk:"name"`
 	ProjectKey types.String `tfsdk:"project_key"`
+	FullName   types.String `tfsdk:"full_name"`
+}
+
+// fullName returns the name of the environment in Artifactory, i.e. prefixed with the project key.
+// State written by earlier versions of the provider has no full_name, in which case it is derived
+// from project_key and name.
+func (m ProjectEnvironmentResourceModel) fullName() string {
+	if !m.FullName.IsNull() && !m.FullName.IsUnknown() && m.FullName.ValueString() != "" {
+		return m.FullName.ValueString()
+	}
+
+	return fmt.Sprintf("%s-%s", m.ProjectKey.ValueString(), m.Name.ValueString())
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// saveEnvironments records the project environments in the private state, so a later refresh can
+// tell which environments appeared when the managed one disappeared.
+var saveEnvironments = func(ctx context.Context, environments []string, private privateStateSetter) diag.Diagnostics {
+	value, err := json.Marshal(environments)
+	if err != nil {
+		var ds diag.Diagnostics
+		ds.AddError("failed to save project environments", err.Error())
+		return ds
+	}
+
+	return private.SetKey(ctx, environmentsPrivateStateKey, value)
 }
 
 type ProjectEnvironmentAPIModel struct {
@@ -61,7 +100,7 @@ func (r *ProjectEnvironmentResource) Schema(ctx context.Context, req resource.Sc
 			"name": schema.StringAttribute{
 				Required: true,
 				Validators: []validator.String{
-					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`), "Must start with a letter and contain letters, digits and `-` character."),
+					stringvalidator.RegexMatches(environmentNameRegex, "Must start with a letter and contain letters, digits and `-` character."),
 				},
 				Description: "Environment name. Must start with a letter and can contain letters, digits and `-` character.",
 			},
@@ -75,8 +114,12 @@ func (r *ProjectEnvironmentResource) Schema(ctx context.Context, req resource.Sc
 				},
 				Description: "Project key for this environment. This field supports only 2 - 32 lowercase alphanumeric and hyphen characters. Must begin with a letter.",
 			},
+			"full_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the environment in Artifactory, i.e. `name` prefixed with `project_key` and `-`. Use it to reference the environment in `project_role` and `project_repository`.",
+			},
 		},
-		Description: "Creates a new environment for the specified project.\n\n~>The combined length of `project_key` and `name` (separated by '-') cannot not exceeds 32 characters.",
+		Description: "Creates a new environment for the specified project.\n\n~>The combined length of `project_key` and `name` (separated by '-') cannot not exceeds 32 characters.\n\n->An environment renamed outside Terraform is found on refresh when it is the only environment that appeared in the project since the last refresh. It is kept in the state under its new name, the warning reports the old and the new names, and the next apply renames it back. Otherwise the environment is removed from the state, and created again on the next apply.",
 	}
 }
 
@@ -121,6 +164,14 @@ func (r *ProjectEnvironmentResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	plan.ID = types.StringValue(environment.Name)
+	plan.FullName = types.StringValue(environment.Name)
+
+	environments, err := readEnvironments(ctx, projectKey, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(saveEnvironments(ctx, environments, resp.Private)...)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -137,40 +188,69 @@ func (r *ProjectEnvironmentResource) Read(ctx context.Context, req resource.Read
 	}
 
 	projectKey := state.ProjectKey.ValueString()
+	fullName := state.fullName()
 
-	var environments []ProjectEnvironmentAPIModel
-	var projectError ProjectErrorsResponse
-	response, err := r.ProviderData.Client.R().
-		SetPathParam("projectKey", projectKey).
-		SetResult(&environments).
-		SetError(&projectError).
-		Get(ProjectEnvironmentUrl)
+	environments, err := readEnvironments(ctx, projectKey, r.ProviderData.Client)
 	if err != nil {
 		utilfw.UnableToRefreshResourceError(resp, err.Error())
 		return
 	}
-	if response.IsError() {
-		utilfw.UnableToRefreshResourceError(resp, projectError.String())
-		return
-	}
 
-	matchedEnv, ok := lo.Find(environments, func(env ProjectEnvironmentAPIModel) bool {
-		return env.Name == fmt.Sprintf("%s-%s", projectKey, state.Name.ValueString())
-	})
-	if !ok {
-		resp.State.RemoveResource(ctx)
-		return
+	if !lo.Contains(environments, fullName) {
+		newFullNames, ds := r.findNewEnvironments(ctx, req, fullName, environments)
+		resp.Diagnostics.Append(ds...)
+
+		// When it is the only environment that appeared since the last refresh, the environment was renamed,
+		// and is kept under its new name so the next apply renames it back in place.
+		if len(newFullNames) == 1 {
+			newFullName := newFullNames[0]
+			resp.Diagnostics.AddWarning(
+				"environment renamed outside Terraform",
+				fmt.Sprintf("environment %s of project %s was renamed to %s. The next apply renames it back to %s.", fullName, projectKey, newFullName, fullName),
+			)
+
+			fullName = newFullName
+			state.Name = types.StringValue(strings.TrimPrefix(newFullName, fmt.Sprintf("%s-", projectKey)))
+		} else {
+			detail := fmt.Sprintf("environment %s no longer exists in project %s. The next apply creates it again.", fullName, projectKey)
+			if len(newFullNames) > 1 {
+				detail += fmt.Sprintf(" If it was renamed outside Terraform, import it under its new name instead, one of: %s.", formatNames(newFullNames))
+			}
+
+			resp.Diagnostics.AddWarning("environment not found", detail)
+			resp.State.RemoveResource(ctx)
+			return
+		}
 	}
 
-	environmentName := strings.TrimPrefix(matchedEnv.Name, fmt.Sprintf("%s-", projectKey))
-	state.ID = types.StringValue(matchedEnv.Name)
-	state.Name = types.StringValue(environmentName)
+	resp.Diagnostics.Append(saveEnvironments(ctx, environments, resp.Private)...)
+
+	state.ID = types.StringValue(fullName)
+	state.FullName = types.StringValue(fullName)
 	state.ProjectKey = types.StringValue(projectKey)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// findNewEnvironments returns the environments that appeared in the project since the last refresh,
+// which the missing environment may have been renamed to. It returns nothing when the environments
+// of the last refresh are unknown.
+func (r *ProjectEnvironmentResource) findNewEnvironments(ctx context.Context, req resource.ReadRequest, fullName string, environments []string) ([]string, diag.Diagnostics) {
+	value, ds := req.Private.GetKey(ctx, environmentsPrivateStateKey)
+	if ds.HasError() || value == nil {
+		return nil, ds
+	}
+
+	var previousEnvironments []string
+	if err := json.Unmarshal(value, &previousEnvironments); err != nil || !lo.Contains(previousEnvironments, fullName) {
+		return nil, ds
+	}
+
+	_, newEnvironments := lo.Difference(previousEnvironments, environments)
+	return newEnvironments, ds
+}
+
 func (r *ProjectEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
@@ -189,7 +269,6 @@ func (r *ProjectEnvironmentResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	oldName := state.Name.ValueString()
 	newName := plan.Name.ValueString()
 	projectKey := plan.ProjectKey.ValueString()
 
@@ -201,7 +280,7 @@ func (r *ProjectEnvironmentResource) Update(ctx context.Context, req resource.Up
 	response, err := r.ProviderData.Client.R().
 		SetPathParams(map[string]string{
 			"projectKey":      projectKey,
-			"environmentName": fmt.Sprintf("%s-%s", projectKey, oldName),
+			"environmentName": state.fullName(),
 		}).
 		SetBody(environmentUpdate).
 		SetError(&projectError).
@@ -216,8 +295,16 @@ func (r *ProjectEnvironmentResource) Update(ctx context.Context, req resource.Up
 	}
 
 	plan.ID = types.StringValue(environmentUpdate.NewName)
+	plan.FullName = types.StringValue(environmentUpdate.NewName)
 	plan.Name = types.StringValue(newName)
 
+	environments, err := readEnvironments(ctx, projectKey, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(saveEnvironments(ctx, environments, resp.Private)...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -239,7 +326,7 @@ func (r *ProjectEnvironmentResource) Delete(ctx context.Context, req resource.De
 	response, err := r.ProviderData.Client.R().
 		SetPathParams(map[string]string{
 			"projectKey":      projectKey,
-			"environmentName": fmt.Sprintf("%s-%s", projectKey, state.Name.ValueString()),
+			"environmentName": state.fullName(),
 		}).
 		SetError(&projectError).
 		Delete(ProjectEnvironmentUrl + "/{environmentName}")
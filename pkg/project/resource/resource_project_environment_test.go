@@ -9,6 +9,8 @@ import (
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
 	project "github.com/jfrog/terraform-provider-project/pkg/project/resource"
 	"github.com/jfrog/terraform-provider-shared/testutil"
@@ -144,6 +146,7 @@ func TestAccProjectEnvironment_full(t *testing.T) {
 				Config: enviroment,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s-%s", projectKey, params["name"])),
+					resource.TestCheckResourceAttr(resourceName, "full_name", fmt.Sprintf("%s-%s", projectKey, params["name"])),
 					resource.TestCheckResourceAttr(resourceName, "name", params["name"].(string)),
 					resource.TestCheckResourceAttr(resourceName, "project_key", params["project_key"].(string)),
 				),
@@ -152,6 +155,7 @@ func TestAccProjectEnvironment_full(t *testing.T) {
 				Config: enviromentUpdated,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s-%s", projectKey, updateParams["name"])),
+					resource.TestCheckResourceAttr(resourceName, "full_name", fmt.Sprintf("%s-%s", projectKey, updateParams["name"])),
 					resource.TestCheckResourceAttr(resourceName, "name", updateParams["name"].(string)),
 					resource.TestCheckResourceAttr(resourceName, "project_key", updateParams["project_key"].(string)),
 				),
@@ -167,6 +171,213 @@ func TestAccProjectEnvironment_full(t *testing.T) {
 	})
 }
 
+func TestAccProjectEnvironment_renamed_outside(t *testing.T) {
+	client := acctest.GetTestResty(t)
+
+	name := strings.ToLower(acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project_environment.%s", name)
+	fullName := fmt.Sprintf("%s-%s", projectKey, name)
+
+	params := map[string]any{
+		"name":        name,
+		"project_key": projectKey,
+	}
+
+	template := `
+		resource "project" "{{ .project_key }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_key }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_environment" "{{ .name }}" {
+			name        = "{{ .name }}"
+			project_key = project.{{ .project_key }}.key
+		}
+	`
+
+	enviroment := util.ExecuteTemplate("TestAccProjectEnvironment", template, params)
+
+	renamedFullName := fmt.Sprintf("%s-%s", projectKey, strings.ToLower(acctest.RandSeq(10)))
+
+	// Renames the environment directly via the Access API, simulating an
+	// out-of-band change (e.g. another user or the UI).
+	renameOutOfBand := func() {
+		resp, err := client.R().
+			SetPathParams(map[string]string{
+				"projectKey":      projectKey,
+				"environmentName": fullName,
+			}).
+			SetBody(project.ProjectEnvironmentUpdateAPIModel{
+				NewName: renamedFullName,
+			}).
+			Post(project.ProjectEnvironmentUrl + "/{environmentName}/rename")
+		if err != nil {
+			t.Fatalf("failed to rename environment out-of-band: %v", err)
+		}
+		if resp.IsError() {
+			t.Fatalf("failed to rename environment out-of-band: %s", resp.String())
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		CheckDestroy: acctest.VerifyDeleted(resourceName, func(id string, request *resty.Request) (*resty.Response, error) {
+			return verifyEnvironment(projectKey, id, request)
+		}),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: enviroment,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "full_name", fullName),
+				),
+			},
+			{
+				// The refresh keeps the environment under its new name, and the apply renames it back in place
+				PreConfig: renameOutOfBand,
+				Config:    enviroment,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fullName),
+					resource.TestCheckResourceAttr(resourceName, "full_name", fullName),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					checkEnvironmentExists(client, projectKey, fullName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectEnvironment_deleted_and_unrelated_created_outside(t *testing.T) {
+	client := acctest.GetTestResty(t)
+
+	name := strings.ToLower(acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project_environment.%s", name)
+	fullName := fmt.Sprintf("%s-%s", projectKey, name)
+	unrelatedFullNames := []string{
+		fmt.Sprintf("%s-%s", projectKey, strings.ToLower(acctest.RandSeq(10))),
+		fmt.Sprintf("%s-%s", projectKey, strings.ToLower(acctest.RandSeq(10))),
+	}
+
+	params := map[string]any{
+		"name":        name,
+		"project_key": projectKey,
+	}
+
+	template := `
+		resource "project" "{{ .project_key }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_key }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_environment" "{{ .name }}" {
+			name        = "{{ .name }}"
+			project_key = project.{{ .project_key }}.key
+		}
+	`
+
+	enviroment := util.ExecuteTemplate("TestAccProjectEnvironment", template, params)
+
+	// Deletes the environment and creates unrelated ones directly via the
+	// Access API, so none of them can be told to be the renamed environment.
+	replaceOutOfBand := func() {
+		resp, err := client.R().
+			SetPathParams(map[string]string{
+				"projectKey":      projectKey,
+				"environmentName": fullName,
+			}).
+			Delete(project.ProjectEnvironmentUrl + "/{environmentName}")
+		if err != nil {
+			t.Fatalf("failed to delete environment out-of-band: %v", err)
+		}
+		if resp.IsError() {
+			t.Fatalf("failed to delete environment out-of-band: %s", resp.String())
+		}
+
+		for _, unrelatedFullName := range unrelatedFullNames {
+			resp, err = client.R().
+				SetPathParam("projectKey", projectKey).
+				SetBody(project.ProjectEnvironmentAPIModel{
+					Name: unrelatedFullName,
+				}).
+				Post(project.ProjectEnvironmentUrl)
+			if err != nil {
+				t.Fatalf("failed to create environment out-of-band: %v", err)
+			}
+			if resp.IsError() {
+				t.Fatalf("failed to create environment out-of-band: %s", resp.String())
+			}
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		CheckDestroy: acctest.VerifyDeleted(resourceName, func(id string, request *resty.Request) (*resty.Response, error) {
+			return verifyEnvironment(projectKey, id, request)
+		}),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: enviroment,
+			},
+			{
+				// The unrelated environments must not be adopted, nor renamed
+				PreConfig: replaceOutOfBand,
+				Config:    enviroment,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "full_name", fullName),
+					checkEnvironmentExists(client, projectKey, unrelatedFullNames[0]),
+					checkEnvironmentExists(client, projectKey, unrelatedFullNames[1]),
+				),
+			},
+		},
+	})
+}
+
+func checkEnvironmentExists(client *resty.Client, projectKey, fullName string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		var envs []project.ProjectEnvironmentAPIModel
+		resp, err := client.R().
+			SetPathParam("projectKey", projectKey).
+			SetResult(&envs).
+			Get(project.ProjectEnvironmentUrl)
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return fmt.Errorf("%s", resp.String())
+		}
+
+		if !slices.ContainsFunc(envs, func(env project.ProjectEnvironmentAPIModel) bool {
+			return env.Name == fullName
+		}) {
+			return fmt.Errorf("environment %s does not exist in project %s", fullName, projectKey)
+		}
+		return nil
+	}
+}
+
 func TestAccProjectEnvironment_invalid_length(t *testing.T) {
 	name := fmt.Sprintf("env%s", strings.ToLower(acctest.RandSeq(15)))
 	projectKey := fmt.Sprintf("project%s", strings.ToLower(acctest.RandSeq(7)))
@@ -0,0 +1,219 @@
+package project
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	"github.com/samber/lo"
+)
+
+const GlobalEnvironmentUrl = "/access/api/v1/environments"
+
+func NewProjectGlobalEnvironmentResource() resource.Resource {
+	return &ProjectGlobalEnvironmentResource{
+		TypeName: "project_global_environment",
+	}
+}
+
+type ProjectGlobalEnvironmentResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ProjectGlobalEnvironmentResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *ProjectGlobalEnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ProjectGlobalEnvironmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(environmentNameRegex, "Must start with a letter and contain letters, digits and `-` character."),
+					stringvalidator.LengthAtMost(32),
+					stringvalidator.NoneOf(validRoleEnvironments...),
+				},
+				Description: "Environment name. Must start with a letter and can contain letters, digits and `-` character, up to 32 characters. " +
+					"Changing it renames the environment in place. The environment can be referenced by name in `project_role` and `project_repository` in every project.",
+			},
+		},
+		Description: "Creates a platform-wide environment, available to every project in addition to the pre-defined `DEV` and `PROD` environments.",
+	}
+}
+
+func (r *ProjectGlobalEnvironmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (r *ProjectGlobalEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectGlobalEnvironmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environment := ProjectEnvironmentAPIModel{
+		Name: plan.Name.ValueString(),
+	}
+
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetBody(environment).
+		SetError(&projectError).
+		Post(GlobalEnvironmentUrl)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() {
+		utilfw.UnableToCreateResourceError(resp, projectError.String())
+		return
+	}
+
+	plan.ID = types.StringValue(environment.Name)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectGlobalEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectGlobalEnvironmentResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var environments []ProjectEnvironmentAPIModel
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetResult(&environments).
+		SetError(&projectError).
+		Get(GlobalEnvironmentUrl)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() {
+		utilfw.UnableToRefreshResourceError(resp, projectError.String())
+		return
+	}
+
+	matchedEnv, ok := lo.Find(environments, func(env ProjectEnvironmentAPIModel) bool {
+		return env.Name == state.Name.ValueString()
+	})
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(matchedEnv.Name)
+	state.Name = types.StringValue(matchedEnv.Name)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectGlobalEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectGlobalEnvironmentResourceModel
+	var state ProjectGlobalEnvironmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentUpdate := ProjectEnvironmentUpdateAPIModel{
+		NewName: plan.Name.ValueString(),
+	}
+
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetPathParam("environmentName", state.Name.ValueString()).
+		SetBody(environmentUpdate).
+		SetError(&projectError).
+		Post(GlobalEnvironmentUrl + "/{environmentName}/rename")
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() {
+		utilfw.UnableToUpdateResourceError(resp, projectError.String())
+		return
+	}
+
+	plan.ID = types.StringValue(environmentUpdate.NewName)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectGlobalEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectGlobalEnvironmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetPathParam("environmentName", state.Name.ValueString()).
+		SetError(&projectError).
+		Delete(GlobalEnvironmentUrl + "/{environmentName}")
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() && response.StatusCode() != http.StatusNotFound {
+		utilfw.UnableToDeleteResourceError(resp, projectError.String())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *ProjectGlobalEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
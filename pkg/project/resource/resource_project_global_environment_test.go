@@ -0,0 +1,92 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectGlobalEnvironment_full(t *testing.T) {
+	name := fmt.Sprintf("env%s", strings.ToUpper(acctest.RandSeq(8)))
+	newName := fmt.Sprintf("%s-renamed", name)
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+
+	resourceName := "project_global_environment.test"
+
+	template := `
+		resource "project_global_environment" "test" {
+			name = "{{ .name }}"
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_role" "test" {
+			name         = "test-role"
+			type         = "CUSTOM"
+			project_key  = project.{{ .project_name }}.key
+			environments = [project_global_environment.test.name]
+			actions      = ["READ_REPOSITORY"]
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProjectGlobalEnvironment", template, map[string]string{
+		"name":         name,
+		"project_key":  projectKey,
+		"project_name": projectName,
+	})
+
+	configRenamed := util.ExecuteTemplate("TestAccProjectGlobalEnvironment", template, map[string]string{
+		"name":         newName,
+		"project_key":  projectKey,
+		"project_name": projectName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckTypeSetElemAttr("project_role.test", "environments.*", name),
+				),
+			},
+			{
+				Config: configRenamed,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", newName),
+					resource.TestCheckResourceAttr(resourceName, "name", newName),
+					resource.TestCheckTypeSetElemAttr("project_role.test", "environments.*", newName),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateId:                        newName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -38,6 +39,9 @@ type ProjectGroupResourceModel struct {
 	Name       types.String `tfsdk:"name"`
 	ProjectKey types.String `tfsdk:"project_key"`
 	Roles      types.Set    `tfsdk:"roles"`
+
+	IgnoreMissingGroup types.Bool   `tfsdk:"ignore_missing_group"`
+	MembershipStatus   types.String `tfsdk:"membership_status"`
 }
 
 type ProjectGroupAPIModel struct {
@@ -82,7 +86,17 @@ func (r *ProjectGroupResource) Schema(ctx context.Context, req resource.SchemaRe
 				Validators: []validator.Set{
 					setvalidator.SizeAtLeast(1),
 				},
-				Description: "List of pre-defined Project or custom roles. Must have at least 1 role, e.g. 'Viewer'",
+				Description: "List of pre-defined Project or custom roles. Must have at least 1 role, e.g. 'Viewer'. Roles that do not exist in the project fail the plan, or the apply when the project is created in the same apply. Reference roles created by `project_role` by name, e.g. `project_role.<name>.name`, so they are created first.",
+			},
+			"ignore_missing_group": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true`, the resource will not fail if the group does not exist. Default to `false`. This is useful when the group is managed by an external identity provider (e.g. LDAP, SAML, SCIM) and wasn't synced yet.",
+			},
+			"membership_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the project membership: `active`, or `pending` when the group does not exist yet and `ignore_missing_group` is set. A pending membership is checked on every plan, and created by the next apply once the group exists.",
 			},
 		},
 		Description: "Add a group as project member. Element has one to one mapping with the [JFrog Project Groups API](https://jfrog.com/help/r/jfrog-rest-apis/update-group-in-project). Requires a user assigned with the 'Administer the Platform' role or Project Admin permissions if `admin_privileges.manage_resoures` is enabled.",
@@ -116,6 +130,12 @@ func (r *ProjectGroupResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	// The API accepts unknown roles but silently drops them from the assignment
+	if err := checkRolesExist(ctx, projectKey, roles, nil, r.ProviderData.Client); err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
 	group := ProjectGroupAPIModel{
 		Name:  plan.Name.ValueString(),
 		Roles: roles,
@@ -132,11 +152,30 @@ func (r *ProjectGroupResource) Create(ctx context.Context, req resource.CreateRe
 		Put(ProjectGroupsUrl)
 	if err != nil {
 		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
 	}
-	if response.IsError() {
+	if response.StatusCode() == http.StatusNotFound {
+		if plan.IgnoreMissingGroup.ValueBool() {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("group '%s' not found", group.Name),
+				"but ignore_missing_group is set to true, project membership not created",
+			)
+			plan.MembershipStatus = types.StringValue(membershipStatusPending)
+		} else {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("group '%s' not found", group.Name),
+				"project membership not created",
+			)
+			return
+		}
+	} else if response.IsError() {
 		utilfw.UnableToCreateResourceError(resp, projectError.String())
+		return
 	}
 
+	if plan.MembershipStatus.IsUnknown() {
+		plan.MembershipStatus = types.StringValue(membershipStatusActive)
+	}
 	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", projectKey, group.Name))
 
 	// Save data into Terraform state
@@ -170,6 +209,17 @@ func (r *ProjectGroupResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 	if response.StatusCode() == http.StatusNotFound {
+		if state.IgnoreMissingGroup.ValueBool() {
+			// keep the membership in state as pending, the plan performs the update once the group exists
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("group '%s' not found", state.Name.ValueString()),
+				"but ignore_missing_group is set to true, project membership is pending until the group exists",
+			)
+			state.MembershipStatus = types.StringValue(membershipStatusPending)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -188,6 +238,11 @@ func (r *ProjectGroupResource) Read(ctx context.Context, req resource.ReadReques
 	}
 	state.Roles = roles
 
+	if state.IgnoreMissingGroup.IsNull() {
+		state.IgnoreMissingGroup = types.BoolValue(false)
+	}
+	state.MembershipStatus = types.StringValue(membershipStatusActive)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -211,6 +266,12 @@ func (r *ProjectGroupResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	// The API accepts unknown roles but silently drops them from the assignment
+	if err := checkRolesExist(ctx, projectKey, roles, nil, r.ProviderData.Client); err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
 	group := ProjectGroupAPIModel{
 		Name:  plan.Name.ValueString(),
 		Roles: roles,
@@ -227,11 +288,30 @@ func (r *ProjectGroupResource) Update(ctx context.Context, req resource.UpdateRe
 		Put(ProjectGroupsUrl)
 	if err != nil {
 		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
 	}
-	if response.IsError() {
+	if response.StatusCode() == http.StatusNotFound {
+		if plan.IgnoreMissingGroup.ValueBool() {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("group '%s' not found", group.Name),
+				"but ignore_missing_group is set to true, project membership not updated",
+			)
+			plan.MembershipStatus = types.StringValue(membershipStatusPending)
+		} else {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("group '%s' not found", group.Name),
+				"project membership not updated",
+			)
+			return
+		}
+	} else if response.IsError() {
 		utilfw.UnableToUpdateResourceError(resp, projectError.String())
+		return
 	}
 
+	if plan.MembershipStatus.IsUnknown() {
+		plan.MembershipStatus = types.StringValue(membershipStatusActive)
+	}
 	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", projectKey, group.Name))
 
 	// Save data into Terraform state
@@ -263,7 +343,8 @@ func (r *ProjectGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 		utilfw.UnableToDeleteResourceError(resp, err.Error())
 		return
 	}
-	if response.IsError() {
+	// The membership was never created when the group is still missing
+	if response.IsError() && response.StatusCode() != http.StatusNotFound {
 		utilfw.UnableToDeleteResourceError(resp, projectError.String())
 		return
 	}
@@ -272,6 +353,54 @@ func (r *ProjectGroupResource) Delete(ctx context.Context, req resource.DeleteRe
 	// the resource from state if there are no other errors.
 }
 
+func (r *ProjectGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Release the claim of the prior state, the resource may be destroyed, or moved to another
+	// project or name. The planned claim is registered again below.
+	if !req.State.Raw.IsNull() {
+		var state ProjectGroupResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		managementRegistryFor(r.ProviderData.Client).ReleaseStandalone(state.ProjectKey.ValueString(), groupsManagement.Kind, state.Name.ValueString())
+	}
+
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectKey.IsUnknown() || plan.Name.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkStandaloneClaim(plan.ProjectKey.ValueString(), groupsManagement, plan.Name.ValueString(), r.ProviderData.Client)...)
+	resp.Diagnostics.Append(checkPlannedRoles(ctx, plan.ProjectKey.ValueString(), plan.Roles, r.ProviderData.Client)...)
+
+	// A pending membership is created by the next apply once the group exists
+	if plan.MembershipStatus.ValueString() == membershipStatusPending {
+		exists, err := groupExists(ctx, plan.Name.ValueString(), r.ProviderData.Client)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("failed to check group '%s'", plan.Name.ValueString()),
+				err.Error(),
+			)
+			return
+		}
+
+		if exists {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("membership_status"), types.StringUnknown())...)
+		}
+	}
+}
+
 // ImportState imports the resource into the Terraform state.
 func (r *ProjectGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.SplitN(req.ID, ":", 2)
@@ -359,6 +359,216 @@ func TestAccProjectGroup_invalid_roles(t *testing.T) {
 	})
 }
 
+func TestAccProjectGroup_unknown_role(t *testing.T) {
+	_, _, projectName := testutil.MkNames("test-project-", "project")
+	_, _, groupName := testutil.MkNames("test-project-group-", "project_group")
+
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	params := map[string]string{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"group":        groupName,
+	}
+
+	template := `
+		resource "artifactory_group" "{{ .group }}" {
+			name = "{{ .group }}"
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			description = "test description"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		resource "project_group" "{{ .group }}" {
+			project_key = project.{{ .project_name }}.key
+			name = artifactory_group.{{ .group }}.name
+			roles = ["non-existent-role"]
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProjectGroup", template, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				// The project is created in the same apply, so the unknown role is reported before the assignment
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*roles non-existent-role do not exist in project.*`),
+			},
+		},
+	})
+}
+
+func TestAccProjectGroup_missing_group_fails(t *testing.T) {
+	_, _, projectName := testutil.MkNames("test-project-", "project")
+	_, _, groupName := testutil.MkNames("test-project-group-", "project_group")
+
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	params := map[string]string{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"group":        groupName,
+	}
+
+	template := `
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			description = "test description"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		resource "project_group" "{{ .group }}" {
+			project_key = project.{{ .project_name }}.key
+			name = "{{ .group }}"
+			roles = ["Developer"]
+			ignore_missing_group = false
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProjectGroup", template, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`project membership not created.*`),
+			},
+		},
+	})
+}
+
+func TestAccProjectGroup_missing_group_ignored(t *testing.T) {
+	_, _, projectName := testutil.MkNames("test-project-", "project")
+	_, _, groupName := testutil.MkNames("test-project-group-", "project_group")
+
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	resourceName := "project_group." + groupName
+
+	template := `
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			description = "test description"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		{{ if .create_group }}
+		resource "artifactory_group" "{{ .group }}" {
+			name = "{{ .group }}"
+		}
+		{{ end }}
+
+		resource "project_group" "{{ .group }}" {
+			project_key = project.{{ .project_name }}.key
+			name = "{{ .group }}"
+			roles = ["Developer"]
+			ignore_missing_group = true
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProjectGroup", template, map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"group":        groupName,
+		"create_group": false,
+	})
+
+	configWithGroup := util.ExecuteTemplate("TestAccProjectGroup", template, map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"group":        groupName,
+		"create_group": true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		CheckDestroy: acctest.VerifyDeleted(resourceName, func(id string, request *resty.Request) (*resty.Response, error) {
+			return verifyProjectGroup(groupName, projectKey, request)
+		}),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			// the group does not exist, the membership is kept pending without a diff
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_key", projectKey),
+					resource.TestCheckResourceAttr(resourceName, "name", groupName),
+					resource.TestCheckResourceAttr(resourceName, "ignore_missing_group", "true"),
+					resource.TestCheckResourceAttr(resourceName, "membership_status", "pending"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+			// the group is synced, the plan finds the pending membership and plans an update
+			{
+				Config: configWithGroup,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "membership_status", "pending"),
+				),
+			},
+			// the membership is created and converges
+			{
+				Config: configWithGroup,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "membership_status", "active"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "roles.0", "Developer"),
+				),
+			},
+		},
+	})
+}
+
 func verifyProjectGroup(name, projectKey string, request *resty.Request) (*resty.Response, error) {
 	return request.
 		SetPathParams(map[string]string{
@@ -0,0 +1,316 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+)
+
+var bulkGroupsManagement = managementType{
+	Kind:               groupsMembershipType,
+	NestedAttribute:    groupsManagement.NestedAttribute,
+	UseResourceFlag:    groupsManagement.UseResourceFlag,
+	StandaloneResource: "project_groups",
+}
+
+func NewProjectGroupsResource() resource.Resource {
+	return &ProjectGroupsResource{
+		TypeName: "project_groups",
+	}
+}
+
+type ProjectGroupsResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ProjectGroupsResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ProjectKey    types.String `tfsdk:"project_key"`
+	Groups        types.Set    `tfsdk:"groups"`
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+}
+
+func (r *ProjectGroupsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ProjectGroupsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The key of the project to which the groups should be assigned to.",
+			},
+			"groups": schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+							Description: "The name of an artifactory group.",
+						},
+						"roles": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+							Description: "List of pre-defined Project or custom roles. Must have at least 1 role, e.g. 'Viewer'",
+						},
+					},
+				},
+				Required:    true,
+				Description: "Groups to be assigned to the project with their roles.",
+			},
+			"authoritative": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				Description: "When set to `true`, this resource owns the full group membership of the project: groups not listed in `groups` are removed from the project, and show up as drift when added outside of Terraform. " +
+					"When set to `false`, only the groups listed in `groups` are managed and other project groups are left alone. Default to `true`.",
+			},
+		},
+		Description: "Manage the group membership of a project in bulk. Element has one to one mapping with the [JFrog Project Groups API](https://jfrog.com/help/r/jfrog-rest-apis/update-group-in-project). Only groups whose roles changed are updated, and all memberships are refreshed with a single list call. Groups that drifted outside of Terraform are reported by name on refresh. " +
+			"Requires a user assigned with the 'Administer the Platform' role or Project Admin permissions if `admin_privileges.manage_resoures` is enabled.\n\n" +
+			"~>This resource should not be used in combination with `project_group` resources or the `group` block of the `project` resource for the same project.",
+	}
+}
+
+func (r *ProjectGroupsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (r *ProjectGroupsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectGroupsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, ds := resourceMemberToAPIModels(ctx, plan.Groups)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := plan.ProjectKey.ValueString()
+
+	var err error
+	if plan.Authoritative.ValueBool() {
+		_, err = updateMembers(ctx, projectKey, groupsMembershipType, groups, r.ProviderData.Client)
+	} else {
+		_, err = updateListedMembers(ctx, projectKey, groupsMembershipType, groups, []MemberAPIModel{}, r.ProviderData.Client)
+	}
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	plan.ID = plan.ProjectKey
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectGroupsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectGroupsResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := state.ProjectKey.ValueString()
+
+	projectGroups, err := readMembers(ctx, projectKey, groupsMembershipType, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	var stateGroups []MemberAPIModel
+	if !state.Groups.IsNull() {
+		var ds diag.Diagnostics
+		stateGroups, ds = resourceMemberToAPIModels(ctx, state.Groups)
+		resp.Diagnostics.Append(ds...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	groups := projectGroups
+	if !state.Authoritative.IsNull() && !state.Authoritative.ValueBool() {
+		// ignore project groups not listed in the configuration
+		groups = SetFromSlice(projectGroups).Intersection(SetFromSlice(stateGroups))
+	}
+
+	// Nothing to compare against on import
+	if !state.Groups.IsNull() {
+		added, removed, changed := diffMembers(stateGroups, groups)
+		if len(added) > 0 || len(removed) > 0 || len(changed) > 0 {
+			resp.Diagnostics.AddWarning(
+				"Project groups changed outside of Terraform",
+				fmt.Sprintf(
+					"The group membership of project '%s' no longer matches the Terraform state.\n\nAdded: %s\nRemoved: %s\nRoles changed: %s",
+					projectKey, formatNames(added), formatNames(removed), formatNames(changed),
+				),
+			)
+		}
+	}
+
+	groupsSet, ds := memberAPIModelsToResourceSet(ctx, groups)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(projectKey)
+	state.Groups = groupsSet
+	if state.Authoritative.IsNull() {
+		state.Authoritative = types.BoolValue(true)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectGroupsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan, state ProjectGroupsResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, ds := resourceMemberToAPIModels(ctx, plan.Groups)
+	resp.Diagnostics.Append(ds...)
+	previousGroups, ds := resourceMemberToAPIModels(ctx, state.Groups)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := plan.ProjectKey.ValueString()
+
+	var err error
+	if plan.Authoritative.ValueBool() {
+		_, err = updateMembers(ctx, projectKey, groupsMembershipType, groups, r.ProviderData.Client)
+	} else {
+		_, err = updateListedMembers(ctx, projectKey, groupsMembershipType, groups, previousGroups, r.ProviderData.Client)
+	}
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	plan.ID = plan.ProjectKey
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectGroupsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectGroupsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, ds := resourceMemberToAPIModels(ctx, state.Groups)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteMembers(ctx, state.ProjectKey.ValueString(), groupsMembershipType, groups, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+func (r *ProjectGroupsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Release the claim of the prior state, the resource may be destroyed, or moved to another
+	// project or name. The planned claim is registered again below.
+	if !req.State.Raw.IsNull() {
+		var state ProjectGroupsResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		managementRegistryFor(r.ProviderData.Client).ReleaseStandalone(state.ProjectKey.ValueString(), bulkGroupsManagement.Kind, r.TypeName)
+	}
+
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectGroupsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectKey.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkStandaloneClaim(plan.ProjectKey.ValueString(), bulkGroupsManagement, r.TypeName, r.ProviderData.Client)...)
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *ProjectGroupsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("project_key"), req, resp)
+}
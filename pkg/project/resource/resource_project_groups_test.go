@@ -0,0 +1,238 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	project "github.com/jfrog/terraform-provider-project/pkg/project/resource"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const projectGroupsTemplate = `
+	resource "artifactory_group" "{{ .group1 }}" {
+		name = "{{ .group1 }}"
+	}
+
+	resource "artifactory_group" "{{ .group2 }}" {
+		name = "{{ .group2 }}"
+	}
+
+	resource "artifactory_group" "{{ .group3 }}" {
+		name = "{{ .group3 }}"
+	}
+
+	resource "project" "{{ .project_name }}" {
+		key          = "{{ .project_key }}"
+		display_name = "{{ .project_name }}"
+		admin_privileges {
+			manage_members   = true
+			manage_resources = true
+			index_resources  = true
+		}
+	}
+
+	resource "project_groups" "{{ .project_key }}" {
+		project_key   = project.{{ .project_name }}.key
+		authoritative = {{ .authoritative }}
+
+		groups = [
+			{{- range $name, $roles := .groups }}
+			{
+				name  = artifactory_group.{{ $name }}.name
+				roles = [{{ range $roles }}"{{ . }}", {{ end }}]
+			},
+			{{- end }}
+		]
+	}
+`
+
+func TestAccProjectGroups_full(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+
+	group1 := fmt.Sprintf("group1%s", strings.ToLower(acctest.RandSeq(5)))
+	group2 := fmt.Sprintf("group2%s", strings.ToLower(acctest.RandSeq(5)))
+	group3 := fmt.Sprintf("group3%s", strings.ToLower(acctest.RandSeq(5)))
+
+	resourceName := fmt.Sprintf("project_groups.%s", projectKey)
+
+	params := map[string]interface{}{
+		"project_name":  projectName,
+		"project_key":   projectKey,
+		"group1":        group1,
+		"group2":        group2,
+		"group3":        group3,
+		"authoritative": true,
+		"groups": map[string][]string{
+			group1: {"Developer"},
+			group2: {"Viewer"},
+		},
+	}
+	config := util.ExecuteTemplate("TestAccProjectGroups", projectGroupsTemplate, params)
+
+	updateParams := map[string]interface{}{
+		"project_name":  projectName,
+		"project_key":   projectKey,
+		"group1":        group1,
+		"group2":        group2,
+		"group3":        group3,
+		"authoritative": true,
+		"groups": map[string][]string{
+			group1: {"Developer", "Viewer"},
+			group3: {"Viewer"},
+		},
+	}
+	configUpdated := util.ExecuteTemplate("TestAccProjectGroups", projectGroupsTemplate, updateParams)
+
+	// add the 3rd group outside of this resource
+	addGroup := func() {
+		resp, err := acctest.GetTestResty(t).R().
+			SetPathParams(map[string]string{
+				"projectKey": projectKey,
+				"name":       group3,
+			}).
+			SetBody(project.ProjectGroupAPIModel{
+				Name:  group3,
+				Roles: []string{"Viewer"},
+			}).
+			Put(project.ProjectGroupsUrl)
+		if err != nil {
+			t.Fatalf("failed to add group %s: %v", group3, err)
+		}
+		if resp.IsError() {
+			t.Fatalf("failed to add group %s: %s", group3, resp.String())
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", projectKey),
+					resource.TestCheckResourceAttr(resourceName, "project_key", projectKey),
+					resource.TestCheckResourceAttr(resourceName, "authoritative", "true"),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "groups.*.name", group1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "groups.*.name", group2),
+				),
+			},
+			{
+				PreConfig: addGroup,
+				Config:    config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "2"),
+				),
+			},
+			{
+				Config: configUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "groups.*.name", group1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "groups.*.name", group3),
+					resource.TestCheckTypeSetElemAttr(resourceName, "groups.*.roles.*", "Developer"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "groups.*.roles.*", "Viewer"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateId:                        projectKey,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_key",
+			},
+		},
+	})
+}
+
+func TestAccProjectGroups_non_authoritative(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+
+	group1 := fmt.Sprintf("group1%s", strings.ToLower(acctest.RandSeq(5)))
+	group2 := fmt.Sprintf("group2%s", strings.ToLower(acctest.RandSeq(5)))
+	group3 := fmt.Sprintf("group3%s", strings.ToLower(acctest.RandSeq(5)))
+
+	resourceName := fmt.Sprintf("project_groups.%s", projectKey)
+
+	params := map[string]interface{}{
+		"project_name":  projectName,
+		"project_key":   projectKey,
+		"group1":        group1,
+		"group2":        group2,
+		"group3":        group3,
+		"authoritative": false,
+		"groups": map[string][]string{
+			group1: {"Developer"},
+			group2: {"Viewer"},
+		},
+	}
+	config := util.ExecuteTemplate("TestAccProjectGroups", projectGroupsTemplate, params)
+
+	// add the 3rd group outside of this resource
+	addGroup := func() {
+		resp, err := acctest.GetTestResty(t).R().
+			SetPathParams(map[string]string{
+				"projectKey": projectKey,
+				"name":       group3,
+			}).
+			SetBody(project.ProjectGroupAPIModel{
+				Name:  group3,
+				Roles: []string{"Viewer"},
+			}).
+			Put(project.ProjectGroupsUrl)
+		if err != nil {
+			t.Fatalf("failed to add group %s: %v", group3, err)
+		}
+		if resp.IsError() {
+			t.Fatalf("failed to add group %s: %s", group3, resp.String())
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "authoritative", "false"),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "2"),
+				),
+			},
+			{
+				PreConfig: addGroup,
+				Config:    config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,330 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+func NewProjectLifecycleStageResource() resource.Resource {
+	return &ProjectLifecycleStageResource{
+		TypeName: "project_lifecycle_stage",
+	}
+}
+
+type ProjectLifecycleStageResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ProjectLifecycleStageResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	ProjectKey   types.String `tfsdk:"project_key"`
+	Category     types.String `tfsdk:"category"`
+	Environments types.Set    `tfsdk:"environments"`
+}
+
+func (r *ProjectLifecycleStageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ProjectLifecycleStageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Full name of the stage, i.e. `name` prefixed with the project key. Use it to reference the stage in `project_lifecycle`.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(environmentNameRegex, "Must start with a letter and contain letters, digits and `-` character."),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Stage name. Must start with a letter and can contain letters, digits and `-` character. The stage is created as `<project_key>-<name>`.",
+			},
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Project key for this stage.",
+			},
+			"category": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(lifecycleStageCategoryPromote),
+				Validators: []validator.String{
+					stringvalidator.OneOf(lifecycleStageCategoryPromote, lifecycleStageCategoryCode),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Stage category: `promote` for stages Release Bundles are promoted to, or `code` for stages before a Release Bundle is created. Only `promote` stages can be part of the promotion path. Default to `promote`.",
+			},
+			"environments": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				Description: "Environments of the stage, e.g. `DEV`, `PROD`, or the `id` of a `project_environment`. The environments must exist in the project. Default to empty set.",
+			},
+		},
+		Description: "Creates a JFrog Lifecycle stage for the specified project. Stages are ordered into the project's promotion path with the `project_lifecycle` resource.\n\n" +
+			"~>The combined length of `project_key` and `name` (separated by '-') cannot exceed 32 characters.",
+	}
+}
+
+func (r *ProjectLifecycleStageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (r *ProjectLifecycleStageResource) environments(ctx context.Context, projectKey string, environmentsSet types.Set) ([]string, error) {
+	environments := []string{}
+	if ds := environmentsSet.ElementsAs(ctx, &environments, false); ds.HasError() {
+		return nil, fmt.Errorf("failed to read environments")
+	}
+
+	if len(environments) > 0 {
+		if err := checkEnvironmentsExist(ctx, projectKey, environments, r.ProviderData.Client); err != nil {
+			return nil, err
+		}
+	}
+
+	return environments, nil
+}
+
+func (r *ProjectLifecycleStageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectLifecycleStageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := plan.ProjectKey.ValueString()
+
+	environments, err := r.environments(ctx, projectKey, plan.Environments)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	stage := LifecycleStageAPIModel{
+		Name:         fmt.Sprintf("%s-%s", projectKey, plan.Name.ValueString()),
+		Scope:        "project",
+		ProjectKey:   projectKey,
+		Category:     plan.Category.ValueString(),
+		Environments: environments,
+	}
+
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetBody(stage).
+		SetError(&projectError).
+		Post(LifecycleStagesUrl)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() {
+		utilfw.UnableToCreateResourceError(resp, projectError.String())
+		return
+	}
+
+	plan.ID = types.StringValue(stage.Name)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectLifecycleStageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectLifecycleStageResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := state.ProjectKey.ValueString()
+
+	var stage LifecycleStageAPIModel
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetPathParam("stageName", fmt.Sprintf("%s-%s", projectKey, state.Name.ValueString())).
+		SetQueryParam("project_key", projectKey).
+		SetResult(&stage).
+		SetError(&projectError).
+		Get(LifecycleStagesUrl + "/{stageName}")
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+	if response.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if response.IsError() {
+		utilfw.UnableToRefreshResourceError(resp, projectError.String())
+		return
+	}
+
+	environments, ds := types.SetValueFrom(ctx, types.StringType, lo.Ternary(stage.Environments == nil, []string{}, stage.Environments))
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(stage.Name)
+	state.Name = types.StringValue(strings.TrimPrefix(stage.Name, fmt.Sprintf("%s-", projectKey)))
+	state.ProjectKey = types.StringValue(projectKey)
+	state.Category = types.StringValue(stage.Category)
+	state.Environments = environments
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectLifecycleStageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectLifecycleStageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := plan.ProjectKey.ValueString()
+
+	environments, err := r.environments(ctx, projectKey, plan.Environments)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetPathParam("stageName", plan.ID.ValueString()).
+		SetQueryParam("project_key", projectKey).
+		SetBody(LifecycleStageUpdateAPIModel{
+			Environments: environments,
+		}).
+		SetError(&projectError).
+		Patch(LifecycleStagesUrl + "/{stageName}")
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() {
+		utilfw.UnableToUpdateResourceError(resp, projectError.String())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectLifecycleStageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectLifecycleStageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := state.ProjectKey.ValueString()
+
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetPathParam("stageName", fmt.Sprintf("%s-%s", projectKey, state.Name.ValueString())).
+		SetQueryParam("project_key", projectKey).
+		SetError(&projectError).
+		Delete(LifecycleStagesUrl + "/{stageName}")
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() && response.StatusCode() != http.StatusNotFound {
+		utilfw.UnableToDeleteResourceError(resp, projectError.String())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *ProjectLifecycleStageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected project_key:stage_name",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+func (r ProjectLifecycleStageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ProjectLifecycleStageResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := fmt.Sprintf("%s-%s", config.ProjectKey.ValueString(), config.Name.ValueString())
+	if len(name) > 32 {
+		resp.Diagnostics.AddError(
+			"Invalid Attributes Configuration",
+			"Combined length of project_key and name (separated by '-') cannot exceed 32 characters",
+		)
+		return
+	}
+}
@@ -0,0 +1,131 @@
+package project_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProjectLifecycleStage_full(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(6))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+	envName := fmt.Sprintf("env%s", strings.ToLower(acctest.RandSeq(5)))
+	stageName := fmt.Sprintf("qa%s", strings.ToLower(acctest.RandSeq(5)))
+
+	resourceName := fmt.Sprintf("project_lifecycle_stage.%s", stageName)
+
+	template := `
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_environment" "{{ .env_name }}" {
+			name        = "{{ .env_name }}"
+			project_key = project.{{ .project_name }}.key
+		}
+
+		resource "project_lifecycle_stage" "{{ .stage_name }}" {
+			name         = "{{ .stage_name }}"
+			project_key  = project.{{ .project_name }}.key
+			environments = [{{ .environments }}]
+		}
+	`
+
+	params := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"env_name":     envName,
+		"stage_name":   stageName,
+		"environments": fmt.Sprintf("project_environment.%s.id", envName),
+	}
+	config := util.ExecuteTemplate("TestAccProjectLifecycleStage", template, params)
+
+	updateParams := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"env_name":     envName,
+		"stage_name":   stageName,
+		"environments": `"DEV"`,
+	}
+	configUpdated := util.ExecuteTemplate("TestAccProjectLifecycleStage", template, updateParams)
+
+	invalidParams := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"env_name":     envName,
+		"stage_name":   stageName,
+		"environments": `"NON-EXISTENT"`,
+	}
+	configInvalid := util.ExecuteTemplate("TestAccProjectLifecycleStage", template, invalidParams)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s-%s", projectKey, stageName)),
+					resource.TestCheckResourceAttr(resourceName, "name", stageName),
+					resource.TestCheckResourceAttr(resourceName, "category", "promote"),
+					resource.TestCheckResourceAttr(resourceName, "environments.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "environments.*", fmt.Sprintf("%s-%s", projectKey, envName)),
+				),
+			},
+			{
+				Config: configUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "environments.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "environments.*", "DEV"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     fmt.Sprintf("%s:%s", projectKey, stageName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      configInvalid,
+				ExpectError: regexp.MustCompile(`.*do not exist in project.*`),
+			},
+		},
+	})
+}
+
+func TestAccProjectLifecycleStage_name_too_long(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	stageName := fmt.Sprintf("stage%s", strings.ToLower(acctest.RandSeq(20)))
+
+	config := util.ExecuteTemplate("TestAccProjectLifecycleStage", `
+		resource "project_lifecycle_stage" "{{ .stage_name }}" {
+			name        = "{{ .stage_name }}"
+			project_key = "{{ .project_key }}"
+		}
+	`, map[string]interface{}{
+		"project_key": projectKey,
+		"stage_name":  stageName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*cannot exceed 32 characters.*`),
+			},
+		},
+	})
+}
@@ -0,0 +1,125 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const projectLifecycleTemplate = `
+	resource "project" "{{ .project_name }}" {
+		key          = "{{ .project_key }}"
+		display_name = "{{ .project_name }}"
+		admin_privileges {
+			manage_members   = true
+			manage_resources = true
+			index_resources  = true
+		}
+	}
+
+	resource "project_lifecycle_stage" "qa" {
+		name         = "qa"
+		project_key  = project.{{ .project_name }}.key
+		environments = ["DEV"]
+	}
+
+	resource "project_lifecycle_stage" "staging" {
+		name         = "staging"
+		project_key  = project.{{ .project_name }}.key
+		environments = ["DEV"]
+	}
+
+	resource "project_lifecycle_stage" "prod" {
+		name         = "prod"
+		project_key  = project.{{ .project_name }}.key
+		environments = ["PROD"]
+	}
+
+	resource "project_lifecycle" "{{ .project_key }}" {
+		project_key    = project.{{ .project_name }}.key
+		promote_stages = [{{ range .stages }}project_lifecycle_stage.{{ . }}.id, {{ end }}]
+	}
+`
+
+func TestAccProjectLifecycle_full(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+
+	resourceName := fmt.Sprintf("project_lifecycle.%s", projectKey)
+
+	config := util.ExecuteTemplate("TestAccProjectLifecycle", projectLifecycleTemplate, map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"stages":       []string{"qa", "prod"},
+	})
+
+	configUpdated := util.ExecuteTemplate("TestAccProjectLifecycle", projectLifecycleTemplate, map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"stages":       []string{"qa", "staging", "prod"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", projectKey),
+					resource.TestCheckResourceAttr(resourceName, "promote_stages.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "promote_stages.0", fmt.Sprintf("%s-qa", projectKey)),
+					resource.TestCheckResourceAttr(resourceName, "promote_stages.1", fmt.Sprintf("%s-prod", projectKey)),
+				),
+			},
+			{
+				Config: configUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "promote_stages.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "promote_stages.1", fmt.Sprintf("%s-staging", projectKey)),
+					resource.TestCheckResourceAttr(resourceName, "promote_stages.2", fmt.Sprintf("%s-prod", projectKey)),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateId:                        projectKey,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_key",
+			},
+		},
+	})
+}
+
+func TestAccProjectLifecycle_production_stage_not_last(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+
+	resourceName := fmt.Sprintf("project_lifecycle.%s", projectKey)
+
+	config := util.ExecuteTemplate("TestAccProjectLifecycle", projectLifecycleTemplate, map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"stages":       []string{"qa", "prod", "staging"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The Lifecycle API does not require stages promoting to PROD to come last
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "promote_stages.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "promote_stages.1", fmt.Sprintf("%s-prod", projectKey)),
+					resource.TestCheckResourceAttr(resourceName, "promote_stages.2", fmt.Sprintf("%s-staging", projectKey)),
+				),
+			},
+		},
+	})
+}
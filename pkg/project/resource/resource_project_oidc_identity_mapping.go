@@ -0,0 +1,528 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+)
+
+const (
+	oidcIdentityMappingsUrl      = "access/api/v1/oidc/{providerName}/identity_mappings"
+	OIDCIdentityMappingUrl       = oidcIdentityMappingsUrl + "/{name}"
+	oidcIdentityMappingScopeRole = "applied-permissions/roles:"
+)
+
+func NewProjectOIDCIdentityMappingResource() resource.Resource {
+	return &ProjectOIDCIdentityMappingResource{
+		TypeName: "project_oidc_identity_mapping",
+	}
+}
+
+type ProjectOIDCIdentityMappingResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ProjectOIDCIdentityMappingResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	ProviderName types.String `tfsdk:"provider_name"`
+	ProjectKey   types.String `tfsdk:"project_key"`
+	Priority     types.Int64  `tfsdk:"priority"`
+	ClaimsJSON   types.String `tfsdk:"claims_json"`
+	TokenSpec    types.Object `tfsdk:"token_spec"`
+}
+
+type ProjectOIDCTokenSpecResourceModel struct {
+	Roles     types.Set    `tfsdk:"roles"`
+	Username  types.String `tfsdk:"username"`
+	Audience  types.String `tfsdk:"audience"`
+	ExpiresIn types.Int64  `tfsdk:"expires_in"`
+}
+
+var oidcTokenSpecAttrTypes = map[string]attr.Type{
+	"roles":      types.SetType{ElemType: types.StringType},
+	"username":   types.StringType,
+	"audience":   types.StringType,
+	"expires_in": types.Int64Type,
+}
+
+type ProjectOIDCIdentityMappingAPIModel struct {
+	Name        string                       `json:"name"`
+	Description string                       `json:"description,omitempty"`
+	ProjectKey  string                       `json:"project_key"`
+	Priority    int64                        `json:"priority"`
+	Claims      map[string]interface{}       `json:"claims"`
+	TokenSpec   ProjectOIDCTokenSpecAPIModel `json:"token_spec"`
+}
+
+type ProjectOIDCTokenSpecAPIModel struct {
+	Scope     string `json:"scope"`
+	Username  string `json:"username,omitempty"`
+	Audience  string `json:"audience,omitempty"`
+	ExpiresIn int64  `json:"expires_in,omitempty"`
+}
+
+// oidcRolesScope returns the token scope granting the roles in the project, e.g. 'applied-permissions/roles:myproj:Developer,Viewer'
+func oidcRolesScope(projectKey string, roles []string) string {
+	return fmt.Sprintf("%s%s:%s", oidcIdentityMappingScopeRole, projectKey, strings.Join(roles, ","))
+}
+
+// parseOIDCRolesScope returns the project key and roles of a roles token scope
+func parseOIDCRolesScope(scope string) (string, []string, error) {
+	parts := strings.SplitN(strings.TrimPrefix(scope, oidcIdentityMappingScopeRole), ":", 2)
+	if !strings.HasPrefix(scope, oidcIdentityMappingScopeRole) || len(parts) != 2 || parts[1] == "" {
+		return "", nil, fmt.Errorf("token scope '%s' does not grant project roles, expected '%s<project_key>:<roles>'", scope, oidcIdentityMappingScopeRole)
+	}
+
+	return parts[0], strings.Split(parts[1], ","), nil
+}
+
+// claimsEqual compares two claims JSON documents, ignoring formatting and key order
+func claimsEqual(a, b string) bool {
+	var claimsA, claimsB interface{}
+	if err := json.Unmarshal([]byte(a), &claimsA); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &claimsB); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(claimsA, claimsB)
+}
+
+func (r *ProjectOIDCIdentityMappingResourceModel) toAPIModel(ctx context.Context, mapping *ProjectOIDCIdentityMappingAPIModel) diag.Diagnostics {
+	ds := diag.Diagnostics{}
+
+	var claims map[string]interface{}
+	if err := json.Unmarshal([]byte(r.ClaimsJSON.ValueString()), &claims); err != nil {
+		ds.AddAttributeError(path.Root("claims_json"), "Invalid claims JSON", err.Error())
+		return ds
+	}
+
+	var tokenSpec ProjectOIDCTokenSpecResourceModel
+	ds.Append(r.TokenSpec.As(ctx, &tokenSpec, basetypes.ObjectAsOptions{})...)
+	if ds.HasError() {
+		return ds
+	}
+
+	var roles []string
+	ds.Append(tokenSpec.Roles.ElementsAs(ctx, &roles, false)...)
+	if ds.HasError() {
+		return ds
+	}
+	sort.Strings(roles)
+
+	*mapping = ProjectOIDCIdentityMappingAPIModel{
+		Name:        r.Name.ValueString(),
+		Description: r.Description.ValueString(),
+		ProjectKey:  r.ProjectKey.ValueString(),
+		Priority:    r.Priority.ValueInt64(),
+		Claims:      claims,
+		TokenSpec: ProjectOIDCTokenSpecAPIModel{
+			Scope:     oidcRolesScope(r.ProjectKey.ValueString(), roles),
+			Username:  tokenSpec.Username.ValueString(),
+			Audience:  tokenSpec.Audience.ValueString(),
+			ExpiresIn: tokenSpec.ExpiresIn.ValueInt64(),
+		},
+	}
+
+	return ds
+}
+
+func (r *ProjectOIDCIdentityMappingResourceModel) fromAPIModel(ctx context.Context, mapping ProjectOIDCIdentityMappingAPIModel) diag.Diagnostics {
+	ds := diag.Diagnostics{}
+
+	r.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", r.ProjectKey.ValueString(), r.ProviderName.ValueString(), mapping.Name))
+	r.Name = types.StringValue(mapping.Name)
+	r.Priority = types.Int64Value(mapping.Priority)
+
+	r.Description = types.StringNull()
+	if mapping.Description != "" {
+		r.Description = types.StringValue(mapping.Description)
+	}
+
+	claimsJSON, err := json.Marshal(mapping.Claims)
+	if err != nil {
+		ds.AddError("Failed to marshal claims", err.Error())
+		return ds
+	}
+	// Keep the configured formatting unless the claims changed
+	if !claimsEqual(r.ClaimsJSON.ValueString(), string(claimsJSON)) {
+		r.ClaimsJSON = types.StringValue(string(claimsJSON))
+	}
+
+	// A scope that grants other permissions, or roles in another project, is reported as no roles
+	// so the next apply restores it
+	roles := []string{}
+	projectKey, scopeRoles, err := parseOIDCRolesScope(mapping.TokenSpec.Scope)
+	if err != nil {
+		ds.AddWarning("Unexpected token scope", err.Error())
+	} else if projectKey != r.ProjectKey.ValueString() {
+		ds.AddWarning(
+			"Unexpected token scope",
+			fmt.Sprintf("token scope '%s' grants roles in project %s instead of %s", mapping.TokenSpec.Scope, projectKey, r.ProjectKey.ValueString()),
+		)
+	} else {
+		roles = scopeRoles
+	}
+
+	rolesSet, d := types.SetValueFrom(ctx, types.StringType, roles)
+	if d.HasError() {
+		ds.Append(d...)
+	}
+
+	username := types.StringNull()
+	if mapping.TokenSpec.Username != "" {
+		username = types.StringValue(mapping.TokenSpec.Username)
+	}
+
+	tokenSpec, d := types.ObjectValue(
+		oidcTokenSpecAttrTypes,
+		map[string]attr.Value{
+			"roles":      rolesSet,
+			"username":   username,
+			"audience":   types.StringValue(mapping.TokenSpec.Audience),
+			"expires_in": types.Int64Value(mapping.TokenSpec.ExpiresIn),
+		},
+	)
+	if d.HasError() {
+		ds.Append(d...)
+	}
+	r.TokenSpec = tokenSpec
+
+	return ds
+}
+
+func (r *ProjectOIDCIdentityMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ProjectOIDCIdentityMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the identity mapping.",
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Description of the identity mapping.",
+			},
+			"provider_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Name of the OIDC provider the mapping belongs to, e.g. the `name` of a `platform_oidc_configuration` resource.",
+			},
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The key of the project the mapping is scoped to. Tokens issued by the mapping only grant roles in this project.",
+			},
+			"priority": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Priority of the mapping. Mappings are evaluated in ascending order and the first one whose claims match is applied.",
+			},
+			"claims_json": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Claims JSON object the OIDC ID token must match, e.g. `jsonencode({ repository = \"myorg/myrepo\", ref = \"refs/heads/main\" })`. Formatting and key order are ignored when detecting changes.",
+			},
+			"token_spec": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"roles": schema.SetAttribute{
+						ElementType: types.StringType,
+						Required:    true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ValueStringsAre(
+								stringvalidator.RegexMatches(regexp.MustCompile(`^[^,]+$`), "must not be empty or contain commas"),
+							),
+						},
+						Description: "Pre-defined Project or custom roles granted by the token in the project, e.g. `Developer`. Every role must exist in the project.",
+					},
+					"username": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						Description: "User name the token is issued for. When not set, the token is issued for the OIDC subject.",
+					},
+					"audience": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("*@*"),
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						Description: "Audience of the token. Default to `*@*`.",
+					},
+					"expires_in": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+						Default:  int64default.StaticInt64(60),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+						Description: "Expiration of the token in seconds. Default to `60`.",
+					},
+				},
+				Description: "Specification of the access token issued by the mapping, always scoped to the roles of `project_key`.",
+			},
+		},
+		Description: "Manage an OIDC identity mapping scoped to a project. Tokens issued through the mapping, e.g. to GitHub Actions workflows, only grant the given roles in the project. " +
+			"Requires a user assigned with the 'Administer the Platform' role or Project Admin permissions.",
+	}
+}
+
+func (r *ProjectOIDCIdentityMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (r *ProjectOIDCIdentityMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectOIDCIdentityMappingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var mapping ProjectOIDCIdentityMappingAPIModel
+	resp.Diagnostics.Append(plan.toAPIModel(ctx, &mapping)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, roles, _ := parseOIDCRolesScope(mapping.TokenSpec.Scope)
+	if err := checkRolesExist(ctx, mapping.ProjectKey, roles, nil, r.ProviderData.Client); err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetPathParam("providerName", plan.ProviderName.ValueString()).
+		SetBody(mapping).
+		SetError(&projectError).
+		Post(oidcIdentityMappingsUrl)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() {
+		utilfw.UnableToCreateResourceError(resp, projectError.String())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", mapping.ProjectKey, plan.ProviderName.ValueString(), mapping.Name))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectOIDCIdentityMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectOIDCIdentityMappingResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var mapping ProjectOIDCIdentityMappingAPIModel
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"providerName": state.ProviderName.ValueString(),
+			"name":         state.Name.ValueString(),
+		}).
+		SetQueryParam("project_key", state.ProjectKey.ValueString()).
+		SetResult(&mapping).
+		SetError(&projectError).
+		Get(OIDCIdentityMappingUrl)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+	if response.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.AddWarning(
+			"Unable to Refresh Resource",
+			fmt.Sprintf("identity mapping '%s' of OIDC provider '%s' not found, removing from state", state.Name.ValueString(), state.ProviderName.ValueString()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if response.IsError() {
+		utilfw.UnableToRefreshResourceError(resp, projectError.String())
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromAPIModel(ctx, mapping)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectOIDCIdentityMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectOIDCIdentityMappingResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var mapping ProjectOIDCIdentityMappingAPIModel
+	resp.Diagnostics.Append(plan.toAPIModel(ctx, &mapping)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, roles, _ := parseOIDCRolesScope(mapping.TokenSpec.Scope)
+	if err := checkRolesExist(ctx, mapping.ProjectKey, roles, nil, r.ProviderData.Client); err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"providerName": plan.ProviderName.ValueString(),
+			"name":         plan.Name.ValueString(),
+		}).
+		SetQueryParam("project_key", plan.ProjectKey.ValueString()).
+		SetBody(mapping).
+		SetError(&projectError).
+		Put(OIDCIdentityMappingUrl)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() {
+		utilfw.UnableToUpdateResourceError(resp, projectError.String())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", mapping.ProjectKey, plan.ProviderName.ValueString(), mapping.Name))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectOIDCIdentityMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectOIDCIdentityMappingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetPathParams(map[string]string{
+			"providerName": state.ProviderName.ValueString(),
+			"name":         state.Name.ValueString(),
+		}).
+		SetQueryParam("project_key", state.ProjectKey.ValueString()).
+		SetError(&projectError).
+		Delete(OIDCIdentityMappingUrl)
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() && response.StatusCode() != http.StatusNotFound {
+		utilfw.UnableToDeleteResourceError(resp, projectError.String())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *ProjectOIDCIdentityMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected project_key:provider_name:name",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("provider_name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
@@ -0,0 +1,174 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	project "github.com/jfrog/terraform-provider-project/pkg/project/resource"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const projectOIDCIdentityMappingTemplate = `
+	resource "platform_oidc_configuration" "{{ .provider_name }}" {
+		name          = "{{ .provider_name }}"
+		issuer_url    = "https://token.actions.githubusercontent.com"
+		provider_type = "GitHub"
+		audience      = "jfrog-github"
+	}
+
+	resource "project" "{{ .project_key }}" {
+		key          = "{{ .project_key }}"
+		display_name = "{{ .project_key }}"
+		admin_privileges {
+			manage_members   = true
+			manage_resources = true
+			index_resources  = true
+		}
+	}
+
+	resource "project_oidc_identity_mapping" "{{ .name }}" {
+		name          = "{{ .name }}"
+		description   = "Test mapping"
+		provider_name = platform_oidc_configuration.{{ .provider_name }}.name
+		project_key   = project.{{ .project_key }}.key
+		priority      = {{ .priority }}
+
+		claims_json = jsonencode({
+			repository = "jfrog/{{ .name }}"
+		})
+
+		token_spec = {
+			roles      = {{ .roles }}
+			expires_in = 120
+		}
+	}
+`
+
+func TestAccProjectOIDCIdentityMapping_full(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	name := fmt.Sprintf("mapping%s", strings.ToLower(acctest.RandSeq(5)))
+	providerName := fmt.Sprintf("oidc%s", strings.ToLower(acctest.RandSeq(5)))
+	resourceName := "project_oidc_identity_mapping." + name
+
+	params := map[string]interface{}{
+		"name":          name,
+		"provider_name": providerName,
+		"project_key":   projectKey,
+		"priority":      1,
+		"roles":         `["Developer"]`,
+	}
+	config := util.ExecuteTemplate("TestAccProjectOIDCIdentityMapping", projectOIDCIdentityMappingTemplate, params)
+
+	updatedParams := map[string]interface{}{
+		"name":          name,
+		"provider_name": providerName,
+		"project_key":   projectKey,
+		"priority":      2,
+		"roles":         `["Developer", "Viewer"]`,
+	}
+	updatedConfig := util.ExecuteTemplate("TestAccProjectOIDCIdentityMapping", projectOIDCIdentityMappingTemplate, updatedParams)
+
+	client := acctest.GetTestResty(t)
+
+	// Changes the priority and the scope directly via the Access API, simulating an
+	// out-of-band change (e.g. another user or the UI).
+	updateOutOfBand := func() {
+		var mapping project.ProjectOIDCIdentityMappingAPIModel
+		resp, err := verifyOIDCIdentityMapping(providerName, name, projectKey, client.R().SetResult(&mapping))
+		if err != nil {
+			t.Fatalf("failed to read identity mapping: %v", err)
+		}
+		if resp.IsError() {
+			t.Fatalf("failed to read identity mapping: %s", resp.String())
+		}
+
+		mapping.Priority = 10
+		mapping.TokenSpec.Scope = fmt.Sprintf("applied-permissions/roles:%s:Project Admin", projectKey)
+
+		resp, err = client.R().
+			SetPathParams(map[string]string{
+				"providerName": providerName,
+				"name":         name,
+			}).
+			SetQueryParam("project_key", projectKey).
+			SetBody(mapping).
+			Put(project.OIDCIdentityMappingUrl)
+		if err != nil {
+			t.Fatalf("failed to update identity mapping out-of-band: %v", err)
+		}
+		if resp.IsError() {
+			t.Fatalf("failed to update identity mapping out-of-band: %s", resp.String())
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"platform": {
+				Source: "jfrog/platform",
+			},
+		},
+		CheckDestroy: acctest.VerifyDeleted(resourceName, func(id string, request *resty.Request) (*resty.Response, error) {
+			return verifyOIDCIdentityMapping(providerName, name, projectKey, request)
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s:%s:%s", projectKey, providerName, name)),
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "token_spec.roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "token_spec.roles.0", "Developer"),
+					resource.TestCheckResourceAttr(resourceName, "token_spec.audience", "*@*"),
+					resource.TestCheckResourceAttr(resourceName, "token_spec.expires_in", "120"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority", "2"),
+					resource.TestCheckResourceAttr(resourceName, "token_spec.roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "token_spec.roles.*", "Developer"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "token_spec.roles.*", "Viewer"),
+				),
+			},
+			{
+				// The refresh must report the changed priority and roles, so the
+				// apply restores them.
+				PreConfig: updateOutOfBand,
+				Config:    updatedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority", "2"),
+					resource.TestCheckResourceAttr(resourceName, "token_spec.roles.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     fmt.Sprintf("%s:%s:%s", projectKey, providerName, name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func verifyOIDCIdentityMapping(providerName, name, projectKey string, request *resty.Request) (*resty.Response, error) {
+	return request.
+		SetPathParams(map[string]string{
+			"providerName": providerName,
+			"name":         name,
+		}).
+		SetQueryParam("project_key", projectKey).
+		Get(project.OIDCIdentityMappingUrl)
+}
@@ -0,0 +1,310 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+var bulkRepositoriesManagement = managementType{
+	Kind:               repositoriesManagementType,
+	NestedAttribute:    repositoriesManagement.NestedAttribute,
+	UseResourceFlag:    repositoriesManagement.UseResourceFlag,
+	StandaloneResource: "project_repositories",
+}
+
+func NewProjectRepositoriesResource() resource.Resource {
+	return &ProjectRepositoriesResource{
+		TypeName: "project_repositories",
+	}
+}
+
+type ProjectRepositoriesResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ProjectRepositoriesResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ProjectKey    types.String `tfsdk:"project_key"`
+	Repos         types.Set    `tfsdk:"repos"`
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+}
+
+func (r *ProjectRepositoriesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ProjectRepositoriesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The key of the project to which the repositories should be assigned to.",
+			},
+			"repos": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validatorfw_string.RepoKey()),
+				},
+				Description: "Keys of existing repositories to be assigned to the project.",
+			},
+			"authoritative": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				Description: "When set to `true`, repositories assigned to the project but not listed in `repos` are unassigned, and show up as drift when assigned outside of Terraform. " +
+					"When set to `false`, only the repositories listed in `repos` are managed and repositories assigned by other means are ignored. Default to `true`.",
+			},
+		},
+		Description: "Assign a set of repositories to a project in bulk. All assignments are verified with a single polling loop instead of one per repository. " +
+			"Requires a user assigned with the 'Administer the Platform' role or Project Admin permissions if `admin_privileges.manage_resoures` is enabled.\n\n" +
+			"~>This resource should not be used in combination with `project_repository` resources or the `repos` attribute of the `project` resource for the same project.",
+	}
+}
+
+func (r *ProjectRepositoriesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+// syncRepos assigns the planned repositories to the project and unassigns the ones that should no longer be,
+// then waits for all planned repositories to be assigned.
+func (r *ProjectRepositoriesResource) syncRepos(ctx context.Context, projectKey string, planRepoKeys, stateRepoKeys []string, authoritative bool) error {
+	if authoritative {
+		if _, err := updateRepos(ctx, projectKey, planRepoKeys, r.ProviderData.Client); err != nil {
+			return err
+		}
+	} else {
+		projectRepoKeys, err := readRepos(ctx, projectKey, r.ProviderData.Client)
+		if err != nil {
+			return fmt.Errorf("failed to fetch repos for project: %s", err)
+		}
+
+		repoKeysToBeAdded, _ := lo.Difference(planRepoKeys, projectRepoKeys)
+		// only unassign repos previously managed by this resource
+		repoKeysToBeDeleted := lo.Intersect(projectRepoKeys, lo.Without(stateRepoKeys, planRepoKeys...))
+
+		if err := addRepos(ctx, projectKey, repoKeysToBeAdded, r.ProviderData.Client); err != nil {
+			return fmt.Errorf("failed to add repos for project: %s", err)
+		}
+
+		if err := deleteRepos(ctx, repoKeysToBeDeleted, r.ProviderData.Client); err != nil {
+			return fmt.Errorf("failed to delete repos for project: %s", err)
+		}
+	}
+
+	_, err := waitForRepos(ctx, projectKey, planRepoKeys, r.ProviderData.Client)
+	return err
+}
+
+func (r *ProjectRepositoriesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	lockName := "project_repository"
+	GlobalMutex.Lock(lockName)
+	defer GlobalMutex.Unlock(lockName)
+
+	var plan ProjectRepositoriesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var repoKeys []string
+	resp.Diagnostics.Append(plan.Repos.ElementsAs(ctx, &repoKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncRepos(ctx, plan.ProjectKey.ValueString(), repoKeys, []string{}, plan.Authoritative.ValueBool())
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	plan.ID = plan.ProjectKey
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectRepositoriesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectRepositoriesResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := state.ProjectKey.ValueString()
+
+	projectRepoKeys, err := readRepos(ctx, projectKey, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	repoKeys := projectRepoKeys
+	if !state.Authoritative.IsNull() && !state.Authoritative.ValueBool() {
+		var stateRepoKeys []string
+		resp.Diagnostics.Append(state.Repos.ElementsAs(ctx, &stateRepoKeys, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// ignore repos assigned by other means
+		repoKeys = lo.Intersect(projectRepoKeys, stateRepoKeys)
+	}
+	sort.Strings(repoKeys)
+
+	repos, ds := types.SetValueFrom(ctx, types.StringType, repoKeys)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(projectKey)
+	state.Repos = repos
+	if state.Authoritative.IsNull() {
+		state.Authoritative = types.BoolValue(true)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectRepositoriesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	lockName := "project_repository"
+	GlobalMutex.Lock(lockName)
+	defer GlobalMutex.Unlock(lockName)
+
+	var plan, state ProjectRepositoriesResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planRepoKeys, stateRepoKeys []string
+	resp.Diagnostics.Append(plan.Repos.ElementsAs(ctx, &planRepoKeys, false)...)
+	resp.Diagnostics.Append(state.Repos.ElementsAs(ctx, &stateRepoKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncRepos(ctx, plan.ProjectKey.ValueString(), planRepoKeys, stateRepoKeys, plan.Authoritative.ValueBool())
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	plan.ID = plan.ProjectKey
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectRepositoriesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	lockName := "project_repository"
+	GlobalMutex.Lock(lockName)
+	defer GlobalMutex.Unlock(lockName)
+
+	var state ProjectRepositoriesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var repoKeys []string
+	resp.Diagnostics.Append(state.Repos.ElementsAs(ctx, &repoKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := deleteRepos(ctx, repoKeys, r.ProviderData.Client); err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+func (r *ProjectRepositoriesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Release the claim of the prior state, the resource may be destroyed, or moved to another
+	// project or name. The planned claim is registered again below.
+	if !req.State.Raw.IsNull() {
+		var state ProjectRepositoriesResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		managementRegistryFor(r.ProviderData.Client).ReleaseStandalone(state.ProjectKey.ValueString(), bulkRepositoriesManagement.Kind, r.TypeName)
+	}
+
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectRepositoriesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectKey.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkStandaloneClaim(plan.ProjectKey.ValueString(), bulkRepositoriesManagement, r.TypeName, r.ProviderData.Client)...)
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *ProjectRepositoriesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("project_key"), req, resp)
+}
@@ -0,0 +1,201 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const projectRepositoriesTemplate = `
+	resource "artifactory_local_generic_repository" "{{ .repo_key_1 }}" {
+		key = "{{ .repo_key_1 }}"
+
+		lifecycle {
+			ignore_changes = ["project_key", "project_environments"]
+		}
+	}
+
+	resource "artifactory_local_generic_repository" "{{ .repo_key_2 }}" {
+		key = "{{ .repo_key_2 }}"
+
+		lifecycle {
+			ignore_changes = ["project_key", "project_environments"]
+		}
+	}
+
+	resource "artifactory_local_generic_repository" "{{ .repo_key_3 }}" {
+		key = "{{ .repo_key_3 }}"
+
+		lifecycle {
+			ignore_changes = ["project_key", "project_environments"]
+		}
+	}
+
+	resource "project" "{{ .project_name }}" {
+		key          = "{{ .project_key }}"
+		display_name = "{{ .project_name }}"
+		admin_privileges {
+			manage_members   = true
+			manage_resources = true
+			index_resources  = true
+		}
+	}
+
+	resource "project_repositories" "{{ .project_key }}" {
+		project_key   = project.{{ .project_name }}.key
+		repos         = [{{ range .repos }}artifactory_local_generic_repository.{{ . }}.key, {{ end }}]
+		authoritative = {{ .authoritative }}
+	}
+`
+
+func TestAccProjectRepositories_full(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+
+	repoKey1 := fmt.Sprintf("repo%d", testutil.RandomInt())
+	repoKey2 := fmt.Sprintf("repo%d", testutil.RandomInt())
+	repoKey3 := fmt.Sprintf("repo%d", testutil.RandomInt())
+
+	resourceName := fmt.Sprintf("project_repositories.%s", projectKey)
+
+	params := map[string]interface{}{
+		"project_name":  projectName,
+		"project_key":   projectKey,
+		"repo_key_1":    repoKey1,
+		"repo_key_2":    repoKey2,
+		"repo_key_3":    repoKey3,
+		"repos":         []string{repoKey1, repoKey2, repoKey3},
+		"authoritative": true,
+	}
+	config := util.ExecuteTemplate("TestAccProjectRepositories", projectRepositoriesTemplate, params)
+
+	updateParams := map[string]interface{}{
+		"project_name":  projectName,
+		"project_key":   projectKey,
+		"repo_key_1":    repoKey1,
+		"repo_key_2":    repoKey2,
+		"repo_key_3":    repoKey3,
+		"repos":         []string{repoKey1},
+		"authoritative": true,
+	}
+	configUpdated := util.ExecuteTemplate("TestAccProjectRepositories", projectRepositoriesTemplate, updateParams)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		CheckDestroy: testAccCheckProjectRepoCount(t, projectKey, 0),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", projectKey),
+					resource.TestCheckResourceAttr(resourceName, "project_key", projectKey),
+					resource.TestCheckResourceAttr(resourceName, "authoritative", "true"),
+					resource.TestCheckResourceAttr(resourceName, "repos.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "repos.*", repoKey1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "repos.*", repoKey2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "repos.*", repoKey3),
+					testAccCheckProjectRepoCount(t, projectKey, 3),
+				),
+			},
+			{
+				Config: configUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "repos.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "repos.*", repoKey1),
+					testAccCheckProjectRepoCount(t, projectKey, 1),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateId:                        projectKey,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_key",
+			},
+		},
+	})
+}
+
+func TestAccProjectRepositories_additive(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+
+	repoKey1 := fmt.Sprintf("repo%d", testutil.RandomInt())
+	repoKey2 := fmt.Sprintf("repo%d", testutil.RandomInt())
+	repoKey3 := fmt.Sprintf("repo%d", testutil.RandomInt())
+
+	resourceName := fmt.Sprintf("project_repositories.%s", projectKey)
+
+	params := map[string]interface{}{
+		"project_name":  projectName,
+		"project_key":   projectKey,
+		"repo_key_1":    repoKey1,
+		"repo_key_2":    repoKey2,
+		"repo_key_3":    repoKey3,
+		"repos":         []string{repoKey1, repoKey2},
+		"authoritative": false,
+	}
+	config := util.ExecuteTemplate("TestAccProjectRepositories", projectRepositoriesTemplate, params)
+
+	// assign the 3rd repo outside of this resource
+	attachRepo := func() {
+		resp, err := acctest.GetTestResty(t).R().
+			SetPathParams(map[string]string{
+				"projectKey": projectKey,
+				"repoKey":    repoKey3,
+			}).
+			SetQueryParam("force", "true").
+			Put("/access/api/v1/projects/_/attach/repositories/{repoKey}/{projectKey}")
+		if err != nil {
+			t.Fatalf("failed to assign repo %s: %v", repoKey3, err)
+		}
+		if resp.IsError() {
+			t.Fatalf("failed to assign repo %s: %s", repoKey3, resp.String())
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "authoritative", "false"),
+					resource.TestCheckResourceAttr(resourceName, "repos.#", "2"),
+					testAccCheckProjectRepoCount(t, projectKey, 2),
+				),
+			},
+			{
+				PreConfig: attachRepo,
+				Config:    config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "repos.#", "2"),
+					testAccCheckProjectRepoCount(t, projectKey, 3),
+				),
+			},
+		},
+	})
+}
@@ -8,10 +8,15 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,6 +24,7 @@ import (
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
 )
 
 const repositoryEndpoint = "/artifactory/api/repositories/{key}"
@@ -35,14 +41,17 @@ type ProjectRepositoryResource struct {
 }
 
 type ProjectRepositoryResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	Key        types.String `tfsdk:"key"`
-	ProjectKey types.String `tfsdk:"project_key"`
+	ID                 types.String `tfsdk:"id"`
+	Key                types.String `tfsdk:"key"`
+	ProjectKey         types.String `tfsdk:"project_key"`
+	Environments       types.Set    `tfsdk:"environments"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 }
 
 type ProjectRepositoryAPIModel struct {
-	Key        string `json:"key"`
-	ProjectKey string `json:"projectKey"`
+	Key          string   `json:"key"`
+	ProjectKey   string   `json:"projectKey"`
+	Environments []string `json:"environments"`
 }
 
 func (r *ProjectRepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -71,10 +80,26 @@ func (r *ProjectRepositoryResource) Schema(ctx context.Context, req resource.Sch
 				Validators: []validator.String{
 					validatorfw_string.ProjectKey(),
 				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+				Description: "The key of the project to which the repository should be assigned to. Changing it moves the repository to the new project in place, without detaching it first.",
+			},
+			"environments": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
 				},
-				Description: "The key of the project to which the repository should be assigned to.",
+				Description: "Project environments of the repository, e.g. `DEV`, `PROD`, or the name of a custom project environment. The environments must exist in the project; missing environments are reported at plan time when the project already exists. When not set, the environments of the repository are left as is. " +
+					"Do not set `project_environments` on the repository resource of the Artifactory provider when using this attribute.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true`, the repository cannot be detached from the project (destroy or replacement) while it still holds artifacts. Default to `false`.",
 			},
 		},
 		Description: "Assign a repository to a project. Requires a user assigned with the 'Administer the Platform' role or Project Admin permissions if `admin_privileges.manage_resoures` is enabled.",
@@ -107,6 +132,12 @@ func (r *ProjectRepositoryResource) Create(ctx context.Context, req resource.Cre
 	projectKey := plan.ProjectKey.ValueString()
 	repoKey := plan.Key.ValueString()
 
+	environments, ds := r.checkEnvironments(ctx, projectKey, plan.Environments)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var projectError ProjectErrorsResponse
 	response, err := r.ProviderData.Client.R().
 		SetPathParams(map[string]string{
@@ -155,6 +186,11 @@ func (r *ProjectRepositoryResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	if err := r.syncEnvironments(ctx, &plan, environments); err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
 	plan.ID = types.StringValue(fmt.Sprintf("%s-%s", projectKey, repoKey))
 
 	// Save data into Terraform state
@@ -182,6 +218,7 @@ func (r *ProjectRepositoryResource) Read(ctx context.Context, req resource.ReadR
 		)
 	}
 
+	var environments []string
 	var projectError ProjectErrorsResponse
 	if newAPIVersion {
 		// use new project API
@@ -213,6 +250,8 @@ func (r *ProjectRepositoryResource) Read(ctx context.Context, req resource.ReadR
 			resp.State.RemoveResource(ctx)
 			return
 		}
+
+		environments = status.Environments
 	} else {
 		// continue using old repo API for checking
 		var repo ProjectRepositoryAPIModel
@@ -241,20 +280,85 @@ func (r *ProjectRepositoryResource) Read(ctx context.Context, req resource.ReadR
 			resp.State.RemoveResource(ctx)
 			return
 		}
+
+		environments = repo.Environments
 	}
 
 	state.ID = types.StringValue(fmt.Sprintf("%s-%s", projectKey, repoKey))
 	state.ProjectKey = types.StringValue(projectKey)
 
+	environmentsSet, ds := types.SetValueFrom(ctx, types.StringType, lo.Ternary(environments == nil, []string{}, environments))
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Environments = environmentsSet
+
+	// Attribute added without a schema version bump, default it for existing state and import
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ProjectRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddWarning(
-		"Update not supported",
-		"Repository assignment to project cannnot be updated.",
-	)
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	lockName := "project_repository"
+	GlobalMutex.Lock(lockName)
+	defer GlobalMutex.Unlock(lockName)
+
+	var plan, state ProjectRepositoryResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := plan.ProjectKey.ValueString()
+	repoKey := plan.Key.ValueString()
+
+	var environments []string
+	if !plan.Environments.Equal(state.Environments) || !plan.ProjectKey.Equal(state.ProjectKey) {
+		var ds diag.Diagnostics
+		environments, ds = r.checkEnvironments(ctx, projectKey, plan.Environments)
+		resp.Diagnostics.Append(ds...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.ProjectKey.Equal(state.ProjectKey) {
+		// Attaching with force=true reassigns the repository from the current project directly,
+		// so it is never left unassigned and members of the new project keep access
+		err := addRepo(ctx, projectKey, repoKey, r.ProviderData.Client.R())
+		if err != nil {
+			utilfw.UnableToUpdateResourceError(resp, err.Error())
+			return
+		}
+
+		err = waitForRepoAssignment(ctx, repoKey, projectKey, r.ProviderData.Client)
+		if err != nil {
+			utilfw.UnableToUpdateResourceError(resp, err.Error())
+			return
+		}
+	}
+
+	if environments != nil || plan.Environments.IsUnknown() {
+		if err := r.syncEnvironments(ctx, &plan, environments); err != nil {
+			utilfw.UnableToUpdateResourceError(resp, err.Error())
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s-%s", projectKey, repoKey))
+
+	// deletion_protection is only enforced by the provider, so a change of it alone is saved as planned
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ProjectRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -272,6 +376,18 @@ func (r *ProjectRepositoryResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		hasArtifacts, err := repoHasArtifacts(ctx, state.Key.ValueString(), r.ProviderData.Client)
+		if err != nil {
+			utilfw.UnableToDeleteResourceError(resp, err.Error())
+			return
+		}
+		if hasArtifacts {
+			resp.Diagnostics.Append(repoDeletionProtectionError(state.ProjectKey.ValueString(), state.Key.ValueString()))
+			return
+		}
+	}
+
 	var projectError ProjectErrorsResponse
 	response, err := r.ProviderData.Client.R().
 		SetPathParam("repoKey", state.Key.ValueString()).
@@ -290,6 +406,148 @@ func (r *ProjectRepositoryResource) Delete(ctx context.Context, req resource.Del
 	// the resource from state if there are no other errors.
 }
 
+func (r *ProjectRepositoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		var state ProjectRepositoryResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		managementRegistryFor(r.ProviderData.Client).ReleaseStandalone(state.ProjectKey.ValueString(), repositoriesManagement.Kind, state.Key.ValueString())
+
+		resp.Diagnostics.Append(r.checkDeletionProtection(ctx, state)...)
+		return
+	}
+
+	var plan ProjectRepositoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ProjectRepositoryResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// A change of key replaces the assignment, i.e. detaches the repository
+		if !plan.Key.Equal(state.Key) {
+			resp.Diagnostics.Append(r.checkDeletionProtection(ctx, state)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		// Release the claim of the prior state, the repository may be moved to another project or
+		// replaced by another one. The planned claim is registered again below.
+		managementRegistryFor(r.ProviderData.Client).ReleaseStandalone(state.ProjectKey.ValueString(), repositoriesManagement.Kind, state.Key.ValueString())
+
+		// A change of project_key moves the repository in place
+		if !plan.ProjectKey.Equal(state.ProjectKey) {
+			// Environments not managed by this resource may change with the project
+			var configEnvironments types.Set
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("environments"), &configEnvironments)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if configEnvironments.IsNull() {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("environments"), types.SetUnknown(types.StringType))...)
+			}
+		}
+	}
+
+	if plan.ProjectKey.IsUnknown() || plan.Key.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkStandaloneClaim(plan.ProjectKey.ValueString(), repositoriesManagement, plan.Key.ValueString(), r.ProviderData.Client)...)
+
+	// Environments are verified again before the assignment
+	if req.State.Raw.IsNull() || !plan.Environments.Equal(state.Environments) || !plan.ProjectKey.Equal(state.ProjectKey) {
+		resp.Diagnostics.Append(checkPlannedEnvironments(ctx, plan.ProjectKey.ValueString(), plan.Environments, r.ProviderData.Client)...)
+	}
+}
+
+// checkEnvironments verifies that the configured environments exist in the project.
+// Returns nil when the environments are not configured.
+func (r *ProjectRepositoryResource) checkEnvironments(ctx context.Context, projectKey string, environmentsSet types.Set) ([]string, diag.Diagnostics) {
+	ds := diag.Diagnostics{}
+
+	if environmentsSet.IsNull() || environmentsSet.IsUnknown() {
+		return nil, ds
+	}
+
+	environments := []string{}
+	ds.Append(environmentsSet.ElementsAs(ctx, &environments, false)...)
+	if ds.HasError() {
+		return nil, ds
+	}
+
+	if len(environments) > 0 {
+		if err := checkEnvironmentsExist(ctx, projectKey, environments, r.ProviderData.Client); err != nil {
+			ds.AddAttributeError(path.Root("environments"), "Invalid Project Environments", err.Error())
+			return nil, ds
+		}
+	}
+
+	return environments, ds
+}
+
+// syncEnvironments assigns the repository to the configured environments, or reads the current
+// environments of the repository into the model when they are not configured.
+func (r *ProjectRepositoryResource) syncEnvironments(ctx context.Context, plan *ProjectRepositoryResourceModel, environments []string) error {
+	repoKey := plan.Key.ValueString()
+
+	if environments != nil {
+		return updateRepoEnvironments(ctx, repoKey, environments, r.ProviderData.Client)
+	}
+
+	status, err := readRepoStatus(ctx, repoKey, r.ProviderData.Client)
+	if err != nil {
+		return err
+	}
+
+	environmentsSet, ds := types.SetValueFrom(ctx, types.StringType, lo.Ternary(status.Environments == nil, []string{}, status.Environments))
+	if ds.HasError() {
+		return fmt.Errorf("failed to convert environments of repo %s", repoKey)
+	}
+	plan.Environments = environmentsSet
+
+	return nil
+}
+
+func (r *ProjectRepositoryResource) checkDeletionProtection(ctx context.Context, state ProjectRepositoryResourceModel) diag.Diagnostics {
+	ds := diag.Diagnostics{}
+
+	// Provider is not configured during validation
+	if !state.DeletionProtection.ValueBool() || r.ProviderData.Client == nil {
+		return ds
+	}
+
+	hasArtifacts, err := repoHasArtifacts(ctx, state.Key.ValueString(), r.ProviderData.Client)
+	if err != nil {
+		ds.AddWarning("Unable to check repository content", err.Error())
+		return ds
+	}
+	if hasArtifacts {
+		ds.Append(repoDeletionProtectionError(state.ProjectKey.ValueString(), state.Key.ValueString()))
+	}
+
+	return ds
+}
+
+func repoDeletionProtectionError(projectKey, repoKey string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Repository is protected from detaching",
+		fmt.Sprintf("Repository '%s' still holds artifacts and has `deletion_protection` enabled, so it cannot be detached from project '%s'. Remove the artifacts, or set `deletion_protection = false` and apply first.", repoKey, projectKey),
+	)
+}
+
 // ImportState imports the resource into the Terraform state.
 func (r *ProjectRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.SplitN(req.ID, ":", 2)
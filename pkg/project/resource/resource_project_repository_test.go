@@ -2,10 +2,13 @@ package project_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
@@ -246,3 +249,309 @@ func TestAccProjectRepository_full(t *testing.T) {
 		},
 	})
 }
+
+func TestAccProjectRepository_deletion_protection(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+	repoKey := fmt.Sprintf("repo%d", testutil.RandomInt())
+
+	resourceName := fmt.Sprintf("project_repository.%s-%s", projectKey, repoKey)
+
+	template := `
+		resource "artifactory_local_generic_repository" "{{ .repo_key }}" {
+			key = "{{ .repo_key }}"
+
+			lifecycle {
+				ignore_changes = ["project_key", "project_environments"]
+			}
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		{{ if .assigned }}
+		resource "project_repository" "{{ .project_key }}-{{ .repo_key }}" {
+			project_key         = project.{{ .project_name }}.key
+			key                 = artifactory_local_generic_repository.{{ .repo_key }}.key
+			deletion_protection = {{ .deletion_protection }}
+		}
+		{{ end }}
+	`
+
+	params := func(assigned, deletionProtection bool) map[string]interface{} {
+		return map[string]interface{}{
+			"project_name":        projectName,
+			"project_key":         projectKey,
+			"repo_key":            repoKey,
+			"assigned":            assigned,
+			"deletion_protection": deletionProtection,
+		}
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectRepository", template, params(true, true))
+	configUnassigned := util.ExecuteTemplate("TestAccProjectRepository", template, params(false, true))
+	configUnprotected := util.ExecuteTemplate("TestAccProjectRepository", template, params(true, false))
+
+	uploadArtifact := func(_ *terraform.State) error {
+		resp, err := acctest.GetTestResty(t).R().
+			SetPathParam("repoKey", repoKey).
+			SetBody("test artifact").
+			Put("/artifactory/{repoKey}/test/artifact.txt")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return fmt.Errorf("failed to upload artifact: %s", resp.String())
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+					uploadArtifact,
+				),
+			},
+			{
+				Config:      configUnassigned,
+				ExpectError: regexp.MustCompile(`.*Repository is protected from detaching.*`),
+			},
+			{
+				// only the protection flag changes, the assignment is updated in place
+				Config: configUnprotected,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+			{
+				Config: configUnassigned,
+			},
+		},
+	})
+}
+
+func TestAccProjectRepository_move(t *testing.T) {
+	projectKey1 := strings.ToLower(acctest.RandSeq(10))
+	projectName1 := fmt.Sprintf("tftestprojects%s", projectKey1)
+	projectKey2 := strings.ToLower(acctest.RandSeq(10))
+	projectName2 := fmt.Sprintf("tftestprojects%s", projectKey2)
+
+	repoKey := fmt.Sprintf("repo%d", testutil.RandomInt())
+
+	resourceName := fmt.Sprintf("project_repository.%s", repoKey)
+
+	template := `
+		resource "artifactory_local_generic_repository" "{{ .repo_key }}" {
+			key = "{{ .repo_key }}"
+
+			lifecycle {
+				ignore_changes = ["project_key", "project_environments"]
+			}
+		}
+
+		resource "project" "{{ .project_name_1 }}" {
+			key          = "{{ .project_key_1 }}"
+			display_name = "{{ .project_name_1 }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project" "{{ .project_name_2 }}" {
+			key          = "{{ .project_key_2 }}"
+			display_name = "{{ .project_name_2 }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_repository" "{{ .repo_key }}" {
+			project_key = project.{{ .project_name }}.key
+			key         = artifactory_local_generic_repository.{{ .repo_key }}.key
+		}
+	`
+
+	params := map[string]interface{}{
+		"project_name_1": projectName1,
+		"project_key_1":  projectKey1,
+		"project_name_2": projectName2,
+		"project_key_2":  projectKey2,
+		"project_name":   projectName1,
+		"repo_key":       repoKey,
+	}
+	config := util.ExecuteTemplate("TestAccProjectRepository", template, params)
+
+	updateParams := map[string]interface{}{
+		"project_name_1": projectName1,
+		"project_key_1":  projectKey1,
+		"project_name_2": projectName2,
+		"project_key_2":  projectKey2,
+		"project_name":   projectName2,
+		"repo_key":       repoKey,
+	}
+	configUpdated := util.ExecuteTemplate("TestAccProjectRepository", template, updateParams)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_key", projectKey1),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s-%s", projectKey1, repoKey)),
+				),
+			},
+			{
+				Config: configUpdated,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_key", projectKey2),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s-%s", projectKey2, repoKey)),
+					testAccCheckProjectRepoCount(t, projectKey1, 0),
+					testAccCheckProjectRepoCount(t, projectKey2, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectRepository_environments(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+
+	repoKey := fmt.Sprintf("repo%d", testutil.RandomInt())
+	envName := strings.ToLower(acctest.RandSeq(10))
+
+	resourceName := fmt.Sprintf("project_repository.%s", repoKey)
+
+	template := `
+		resource "artifactory_local_generic_repository" "{{ .repo_key }}" {
+			key = "{{ .repo_key }}"
+
+			lifecycle {
+				ignore_changes = ["project_key", "project_environments"]
+			}
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_environment" "{{ .env_name }}" {
+			name        = "{{ .env_name }}"
+			project_key = project.{{ .project_name }}.key
+		}
+
+		resource "project_repository" "{{ .repo_key }}" {
+			project_key  = project.{{ .project_name }}.key
+			key          = artifactory_local_generic_repository.{{ .repo_key }}.key
+			environments = [{{ .environments }}]
+		}
+	`
+
+	params := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"repo_key":     repoKey,
+		"env_name":     envName,
+		"environments": fmt.Sprintf(`"DEV", project_environment.%s.id`, envName),
+	}
+	config := util.ExecuteTemplate("TestAccProjectRepository", template, params)
+
+	updateParams := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"repo_key":     repoKey,
+		"env_name":     envName,
+		"environments": `"PROD"`,
+	}
+	configUpdated := util.ExecuteTemplate("TestAccProjectRepository", template, updateParams)
+
+	invalidParams := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"repo_key":     repoKey,
+		"env_name":     envName,
+		"environments": `"NON-EXISTENT"`,
+	}
+	configInvalid := util.ExecuteTemplate("TestAccProjectRepository", template, invalidParams)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "environments.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "environments.*", "DEV"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "environments.*", fmt.Sprintf("%s-%s", projectKey, envName)),
+				),
+			},
+			{
+				Config: configUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "environments.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "environments.*", "PROD"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     fmt.Sprintf("%s:%s", projectKey, repoKey),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      configInvalid,
+				ExpectError: regexp.MustCompile(`.*Invalid Project Environments.*`),
+			},
+		},
+	})
+}
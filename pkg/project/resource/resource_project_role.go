@@ -131,7 +131,7 @@ func (r *ProjectRoleResource) Schema(ctx context.Context, req resource.SchemaReq
 			"environments": schema.SetAttribute{
 				ElementType: types.StringType,
 				Required:    true,
-				Description: fmt.Sprintf("A repository can be available in different environments. Members with roles defined in the set environment will have access to the repository. List of pre-defined environments (%s)", strings.Join(validRoleEnvironments, ", ")),
+				Description: fmt.Sprintf("A repository can be available in different environments. Members with roles defined in the set environment will have access to the repository. List of pre-defined environments (%s), or the name of a global environment (`project_global_environment`) or project environment (`project_environment`).", strings.Join(validRoleEnvironments, ", ")),
 			},
 			"actions": schema.SetAttribute{
 				ElementType: types.StringType,
@@ -349,6 +349,37 @@ func (r *ProjectRoleResource) Delete(ctx context.Context, req resource.DeleteReq
 	// the resource from state if there are no other errors.
 }
 
+func (r *ProjectRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Release the claim of the prior state, the resource may be destroyed, or moved to another
+	// project or name. The planned claim is registered again below.
+	if !req.State.Raw.IsNull() {
+		var state ProjectRoleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		managementRegistryFor(r.ProviderData.Client).ReleaseStandalone(state.ProjectKey.ValueString(), rolesManagement.Kind, state.Name.ValueString())
+	}
+
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectKey.IsUnknown() || plan.Name.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkStandaloneClaim(plan.ProjectKey.ValueString(), rolesManagement, plan.Name.ValueString(), r.ProviderData.Client)...)
+}
+
 // ImportState imports the resource into the Terraform state.
 func (r *ProjectRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.SplitN(req.ID, ":", 2)
@@ -0,0 +1,575 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+const (
+	roleTemplateStatusInSync  = "in_sync"
+	roleTemplateStatusDrifted = "drifted"
+	roleTemplateStatusMissing = "missing"
+	roleTemplateStatusFailed  = "failed"
+)
+
+func NewProjectRoleTemplateResource() resource.Resource {
+	return &ProjectRoleTemplateResource{
+		TypeName: "project_role_template",
+	}
+}
+
+type ProjectRoleTemplateResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ProjectRoleTemplateResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Environments  types.Set    `tfsdk:"environments"`
+	Actions       types.Set    `tfsdk:"actions"`
+	ProjectKeys   types.Set    `tfsdk:"project_keys"`
+	ProjectStatus types.Map    `tfsdk:"project_status"`
+}
+
+func (r *ProjectRoleTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ProjectRoleTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: fmt.Sprintf(`Name of the role created in every project. The role has the "%s" type.`, customRoleType),
+			},
+			"environments": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: fmt.Sprintf("A repository can be available in different environments. Members with roles defined in the set environment will have access to the repository. List of pre-defined environments (%s), or the name of a global environment (`project_global_environment`).", strings.Join(validRoleEnvironments, ", ")),
+			},
+			"actions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: fmt.Sprintf("List of pre-defined actions (%s)", strings.Join(validRoleActions, ", ")),
+			},
+			"project_keys": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(validatorfw_string.ProjectKey()),
+				},
+				Description: "Keys of the projects the role is created in. Removing a key deletes the role from that project.",
+			},
+			"project_status": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: fmt.Sprintf("Status of the role in each project, keyed by project key: `%s`, `%s` when its environments or actions were changed outside of Terraform, `%s` when it was deleted outside of Terraform, "+
+					"or `%s` when the last apply could not create, update, or delete it. A project removed from `project_keys` is listed until the role is deleted from it. "+
+					"Roles that are not in sync are restored, or deleted, on the next apply.", roleTemplateStatusInSync, roleTemplateStatusDrifted, roleTemplateStatusMissing, roleTemplateStatusFailed),
+			},
+		},
+		Description: "Create the same custom role in many projects from one definition. The role is created, updated, and deleted in every listed project, " +
+			"and refreshing the resource reports the projects where the role was changed or deleted outside of Terraform. A role with the same name that already exists in a listed project is updated to match the template.",
+	}
+}
+
+func (r *ProjectRoleTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+// readProjectRole returns the role of the project, or nil when it does not exist.
+var readProjectRole = func(ctx context.Context, projectKey, roleName string, client *resty.Client) (*ProjectRoleAPIModel, error) {
+	tflog.Debug(ctx, fmt.Sprintf("readProjectRole: %s/%s", projectKey, roleName))
+
+	var role ProjectRoleAPIModel
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetPathParams(map[string]string{
+			"projectKey": projectKey,
+			"roleName":   roleName,
+		}).
+		SetResult(&role).
+		SetError(&projectError).
+		Get(ProjectRoleUrl)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("%s", projectError.String())
+	}
+
+	return &role, nil
+}
+
+// upsertProjectRole creates the role in the project, or updates it when it already exists.
+var upsertProjectRole = func(ctx context.Context, projectKey string, role ProjectRoleAPIModel, client *resty.Client) error {
+	existingRole, err := readProjectRole(ctx, projectKey, role.Name, client)
+	if err != nil {
+		return err
+	}
+
+	var projectError ProjectErrorsResponse
+	request := client.R().
+		SetPathParams(map[string]string{
+			"projectKey": projectKey,
+			"roleName":   role.Name,
+		}).
+		SetBody(role).
+		SetError(&projectError)
+
+	var resp *resty.Response
+	if existingRole == nil {
+		resp, err = request.Post(ProjectRolesUrl)
+	} else {
+		resp, err = request.Put(ProjectRoleUrl)
+	}
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("%s", projectError.String())
+	}
+
+	return nil
+}
+
+var deleteProjectRole = func(ctx context.Context, projectKey, roleName string, client *resty.Client) error {
+	var projectError ProjectErrorsResponse
+	resp, err := client.R().
+		SetPathParams(map[string]string{
+			"projectKey": projectKey,
+			"roleName":   roleName,
+		}).
+		SetError(&projectError).
+		Delete(ProjectRoleUrl)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() && resp.StatusCode() != http.StatusNotFound {
+		return fmt.Errorf("%s", projectError.String())
+	}
+
+	return nil
+}
+
+// formatProjectErrors lists the errors by project key, one per line.
+func formatProjectErrors(projectErrors map[string]error) string {
+	keys := lo.Keys(projectErrors)
+	slices.Sort(keys)
+
+	return strings.Join(lo.Map(keys, func(key string, _ int) string {
+		return fmt.Sprintf("%s: %s", key, projectErrors[key])
+	}), "\n")
+}
+
+// syncRoles creates or updates the role in the projects of the plan where it is not known to be in sync,
+// and deletes it from the projects of the state that are no longer listed. It returns the status of the
+// role by project key, and the errors by project key.
+func (r *ProjectRoleTemplateResource) syncRoles(ctx context.Context, plan ProjectRoleTemplateResourceModel, state *ProjectRoleTemplateResourceModel) (map[string]string, map[string]error, diag.Diagnostics) {
+	ds := diag.Diagnostics{}
+
+	var planProjectKeys, environments, actions []string
+	ds.Append(plan.ProjectKeys.ElementsAs(ctx, &planProjectKeys, false)...)
+	ds.Append(plan.Environments.ElementsAs(ctx, &environments, false)...)
+	ds.Append(plan.Actions.ElementsAs(ctx, &actions, false)...)
+	if ds.HasError() {
+		return nil, nil, ds
+	}
+
+	// Projects where the role is already in sync with an unchanged template can be skipped
+	var stateProjectKeys []string
+	stateProjectStatus := map[string]string{}
+	if state != nil {
+		ds.Append(state.ProjectKeys.ElementsAs(ctx, &stateProjectKeys, false)...)
+		if !state.ProjectStatus.IsNull() && !state.ProjectStatus.IsUnknown() {
+			ds.Append(state.ProjectStatus.ElementsAs(ctx, &stateProjectStatus, false)...)
+		}
+		if ds.HasError() {
+			return nil, nil, ds
+		}
+	}
+	templateChanged := state == nil || !plan.Environments.Equal(state.Environments) || !plan.Actions.Equal(state.Actions)
+
+	role := ProjectRoleAPIModel{
+		Name:         plan.Name.ValueString(),
+		Type:         customRoleType,
+		Environments: environments,
+		Actions:      actions,
+	}
+
+	projectStatus := map[string]string{}
+	projectErrors := map[string]error{}
+
+	for _, projectKey := range planProjectKeys {
+		if !templateChanged && stateProjectStatus[projectKey] == roleTemplateStatusInSync {
+			projectStatus[projectKey] = roleTemplateStatusInSync
+			continue
+		}
+
+		if err := upsertProjectRole(ctx, projectKey, role, r.ProviderData.Client); err != nil {
+			projectErrors[projectKey] = err
+			projectStatus[projectKey] = roleTemplateStatusFailed
+			continue
+		}
+		projectStatus[projectKey] = roleTemplateStatusInSync
+	}
+
+	// The status also lists the projects where a previous deletion failed
+	removedProjectKeys, _ := lo.Difference(lo.Uniq(append(stateProjectKeys, lo.Keys(stateProjectStatus)...)), planProjectKeys)
+	for _, projectKey := range removedProjectKeys {
+		if err := deleteProjectRole(ctx, projectKey, role.Name, r.ProviderData.Client); err != nil {
+			projectErrors[projectKey] = err
+			// Keep tracking the role so the deletion is retried
+			projectStatus[projectKey] = roleTemplateStatusFailed
+		}
+	}
+
+	return projectStatus, projectErrors, ds
+}
+
+// setProjectStatus sets the status of the role by project key, and reports the projects where the role could
+// not be synced as a warning. The resource is not failed, so the projects where it succeeded are kept in the
+// state, and the failed ones are retried on the next apply.
+func setProjectStatus(ctx context.Context, model *ProjectRoleTemplateResourceModel, projectStatus map[string]string, projectErrors map[string]error) diag.Diagnostics {
+	ds := diag.Diagnostics{}
+
+	projectStatusMap, d := types.MapValueFrom(ctx, types.StringType, projectStatus)
+	ds.Append(d...)
+	model.ProjectStatus = projectStatusMap
+
+	if len(projectErrors) > 0 {
+		ds.AddWarning(
+			"role not synced in every project",
+			fmt.Sprintf("role %s could not be synced in some projects, which are retried on the next apply:\n%s", model.Name.ValueString(), formatProjectErrors(projectErrors)),
+		)
+	}
+
+	return ds
+}
+
+func (r *ProjectRoleTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectRoleTemplateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectStatus, projectErrors, ds := r.syncRoles(ctx, plan, nil)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Name
+	resp.Diagnostics.Append(setProjectStatus(ctx, &plan, projectStatus, projectErrors)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectRoleTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectRoleTemplateResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projectKeys, environments, actions []string
+	resp.Diagnostics.Append(state.ProjectKeys.ElementsAs(ctx, &projectKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// When imported, the template is taken from the role of the first project where it exists
+	imported := state.Actions.IsNull()
+	if !imported {
+		resp.Diagnostics.Append(state.Environments.ElementsAs(ctx, &environments, false)...)
+		resp.Diagnostics.Append(state.Actions.ElementsAs(ctx, &actions, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Projects removed from the configuration where the deletion of the role failed
+	var pendingDeletionProjectKeys []string
+	if !state.ProjectStatus.IsNull() {
+		pendingDeletionProjectKeys, _ = lo.Difference(lo.Keys(state.ProjectStatus.Elements()), projectKeys)
+	}
+
+	roleName := state.Name.ValueString()
+	inSyncProjectKeys := []string{}
+	projectStatus := map[string]string{}
+
+	for _, projectKey := range pendingDeletionProjectKeys {
+		role, err := readProjectRole(ctx, projectKey, roleName, r.ProviderData.Client)
+		if err != nil {
+			utilfw.UnableToRefreshResourceError(resp, fmt.Sprintf("failed to read role %s in project %s: %s", roleName, projectKey, err))
+			return
+		}
+		if role != nil {
+			projectStatus[projectKey] = roleTemplateStatusFailed
+		}
+	}
+
+	for _, projectKey := range projectKeys {
+		role, err := readProjectRole(ctx, projectKey, roleName, r.ProviderData.Client)
+		if err != nil {
+			utilfw.UnableToRefreshResourceError(resp, fmt.Sprintf("failed to read role %s in project %s: %s", roleName, projectKey, err))
+			return
+		}
+
+		if role == nil {
+			projectStatus[projectKey] = roleTemplateStatusMissing
+			continue
+		}
+
+		if imported {
+			environments, actions = role.Environments, role.Actions
+			imported = false
+		}
+
+		if !lo.ElementsMatch(role.Environments, environments) || !lo.ElementsMatch(role.Actions, actions) {
+			projectStatus[projectKey] = roleTemplateStatusDrifted
+			continue
+		}
+
+		projectStatus[projectKey] = roleTemplateStatusInSync
+		inSyncProjectKeys = append(inSyncProjectKeys, projectKey)
+	}
+
+	if imported {
+		resp.Diagnostics.AddWarning(
+			"role not found",
+			fmt.Sprintf("role %s does not exist in any of the projects %s", roleName, formatNames(projectKeys)),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	outOfSyncProjectKeys, _ := lo.Difference(projectKeys, inSyncProjectKeys)
+	if len(outOfSyncProjectKeys) > 0 {
+		slices.Sort(outOfSyncProjectKeys)
+		resp.Diagnostics.AddWarning(
+			"role changed outside of Terraform",
+			fmt.Sprintf("role %s was changed or deleted outside of Terraform in projects: %s", roleName, strings.Join(lo.Map(outOfSyncProjectKeys, func(key string, _ int) string {
+				return fmt.Sprintf("%s (%s)", key, projectStatus[key])
+			}), ", ")),
+		)
+	}
+
+	environmentsSet, ds := types.SetValueFrom(ctx, types.StringType, environments)
+	resp.Diagnostics.Append(ds...)
+	actionsSet, ds := types.SetValueFrom(ctx, types.StringType, actions)
+	resp.Diagnostics.Append(ds...)
+	// Every configured project is kept, the next plan restores the roles that are not in sync
+	projectStatusMap, ds := types.MapValueFrom(ctx, types.StringType, projectStatus)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(roleName)
+	state.Environments = environmentsSet
+	state.Actions = actionsSet
+	state.ProjectStatus = projectStatusMap
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectRoleTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ProjectRoleTemplateResourceModel
+	var state ProjectRoleTemplateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectStatus, projectErrors, ds := r.syncRoles(ctx, plan, &state)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Name
+	resp.Diagnostics.Append(setProjectStatus(ctx, &plan, projectStatus, projectErrors)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectRoleTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectRoleTemplateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projectKeys []string
+	resp.Diagnostics.Append(state.ProjectKeys.ElementsAs(ctx, &projectKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Including the projects removed from the configuration where the deletion of the role failed
+	if !state.ProjectStatus.IsNull() {
+		projectKeys = lo.Uniq(append(projectKeys, lo.Keys(state.ProjectStatus.Elements())...))
+	}
+
+	projectErrors := map[string]error{}
+	for _, projectKey := range projectKeys {
+		if err := deleteProjectRole(ctx, projectKey, state.Name.ValueString(), r.ProviderData.Client); err != nil {
+			projectErrors[projectKey] = err
+		}
+	}
+
+	if len(projectErrors) > 0 {
+		utilfw.UnableToDeleteResourceError(resp, formatProjectErrors(projectErrors))
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+func (r *ProjectRoleTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var stateProjectKeys []string
+	var roleName string
+	stateProjectStatus := map[string]string{}
+	if !req.State.Raw.IsNull() {
+		var state ProjectRoleTemplateResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(state.ProjectKeys.ElementsAs(ctx, &stateProjectKeys, false)...)
+		if !state.ProjectStatus.IsNull() {
+			resp.Diagnostics.Append(state.ProjectStatus.ElementsAs(ctx, &stateProjectStatus, false)...)
+		}
+		roleName = state.Name.ValueString()
+	}
+
+	// Release the claims of the prior state, the resource may be destroyed, or replaced under another
+	// name, or removed from projects. The planned claims are registered again below.
+	for _, projectKey := range stateProjectKeys {
+		managementRegistryFor(r.ProviderData.Client).ReleaseStandalone(projectKey, rolesManagement.Kind, roleName)
+	}
+
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectRoleTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectKeys.IsUnknown() || plan.Name.IsUnknown() {
+		return
+	}
+
+	// Project keys may not be known yet, e.g. when referencing a project created in the same apply
+	planProjectKeys := lo.FilterMap(plan.ProjectKeys.Elements(), func(v attr.Value, _ int) (string, bool) {
+		projectKey, ok := v.(types.String)
+		return projectKey.ValueString(), ok && !projectKey.IsUnknown()
+	})
+
+	for _, projectKey := range planProjectKeys {
+		resp.Diagnostics.Append(checkStandaloneClaim(projectKey, rolesManagement, plan.Name.ValueString(), r.ProviderData.Client)...)
+	}
+
+	// Plan an update to restore, or delete, the roles that are not in sync
+	outOfSync := lo.SomeBy(lo.Values(stateProjectStatus), func(status string) bool {
+		return status != roleTemplateStatusInSync
+	})
+	if outOfSync {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("project_status"), types.MapUnknown(types.StringType))...)
+	}
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *ProjectRoleTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected role_name:project_key1,project_key2,...",
+		)
+		return
+	}
+
+	projectKeys, ds := types.SetValueFrom(ctx, types.StringType, strings.Split(parts[1], ","))
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_keys"), projectKeys)...)
+}
@@ -0,0 +1,228 @@
+package project_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	project "github.com/jfrog/terraform-provider-project/pkg/project/resource"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const projectRoleTemplateTemplate = `
+	resource "project" "{{ .project_key1 }}" {
+		key          = "{{ .project_key1 }}"
+		display_name = "{{ .project_key1 }}"
+		admin_privileges {
+			manage_members   = true
+			manage_resources = true
+			index_resources  = true
+		}
+	}
+
+	resource "project" "{{ .project_key2 }}" {
+		key          = "{{ .project_key2 }}"
+		display_name = "{{ .project_key2 }}"
+		admin_privileges {
+			manage_members   = true
+			manage_resources = true
+			index_resources  = true
+		}
+	}
+
+	resource "project_role_template" "{{ .name }}" {
+		name         = "{{ .name }}"
+		environments = ["DEV"]
+		actions      = [{{ range .actions }}"{{ . }}", {{ end }}]
+		project_keys = [{{ range .project_keys }}project.{{ . }}.key, {{ end }}]
+	}
+`
+
+func TestAccProjectRoleTemplate_full(t *testing.T) {
+	client := acctest.GetTestResty(t)
+
+	name := fmt.Sprintf("role%s", strings.ToLower(acctest.RandSeq(10)))
+	projectKey1 := fmt.Sprintf("proja%s", strings.ToLower(acctest.RandSeq(6)))
+	projectKey2 := fmt.Sprintf("projb%s", strings.ToLower(acctest.RandSeq(6)))
+	resourceName := fmt.Sprintf("project_role_template.%s", name)
+
+	params := map[string]interface{}{
+		"name":         name,
+		"project_key1": projectKey1,
+		"project_key2": projectKey2,
+		"actions":      []string{"READ_REPOSITORY", "READ_BUILD"},
+		"project_keys": []string{projectKey1, projectKey2},
+	}
+	config := util.ExecuteTemplate("TestAccProjectRoleTemplate", projectRoleTemplateTemplate, params)
+
+	updatedParams := map[string]interface{}{
+		"name":         name,
+		"project_key1": projectKey1,
+		"project_key2": projectKey2,
+		"actions":      []string{"READ_REPOSITORY", "READ_BUILD", "ANNOTATE_REPOSITORY"},
+		"project_keys": []string{projectKey1},
+	}
+	updatedConfig := util.ExecuteTemplate("TestAccProjectRoleTemplate", projectRoleTemplateTemplate, updatedParams)
+
+	// Deletes the role directly via the Access API, simulating an
+	// out-of-band change (e.g. another user or the UI).
+	deleteOutOfBand := func() {
+		resp, err := client.R().
+			SetPathParams(map[string]string{
+				"projectKey": projectKey1,
+				"roleName":   name,
+			}).
+			Delete(project.ProjectRoleUrl)
+		if err != nil {
+			t.Fatalf("failed to delete role out-of-band: %v", err)
+		}
+		if resp.IsError() {
+			t.Fatalf("failed to delete role out-of-band: %s", resp.String())
+		}
+	}
+
+	// Changes the actions of the role directly via the Access API, so the role
+	// drifts in the project removed from the configuration afterwards.
+	changeOutOfBand := func() {
+		resp, err := client.R().
+			SetPathParams(map[string]string{
+				"projectKey": projectKey2,
+				"roleName":   name,
+			}).
+			SetBody(project.ProjectRoleAPIModel{
+				Name:         name,
+				Type:         "CUSTOM",
+				Environments: []string{"DEV"},
+				Actions:      []string{"READ_REPOSITORY"},
+			}).
+			Put(project.ProjectRoleUrl)
+		if err != nil {
+			t.Fatalf("failed to update role out-of-band: %v", err)
+		}
+		if resp.IsError() {
+			t.Fatalf("failed to update role out-of-band: %s", resp.String())
+		}
+	}
+
+	checkRoleDeleted := func(projectKey string) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			resp, err := client.R().
+				SetPathParams(map[string]string{
+					"projectKey": projectKey,
+					"roleName":   name,
+				}).
+				Get(project.ProjectRoleUrl)
+			if err != nil {
+				return err
+			}
+			if resp.StatusCode() != http.StatusNotFound {
+				return fmt.Errorf("role %s still exists in project %s", name, projectKey)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "project_keys.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("project_status.%s", projectKey1), "in_sync"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("project_status.%s", projectKey2), "in_sync"),
+				),
+			},
+			{
+				// The refresh must report the missing role, so the apply
+				// creates it again in that project.
+				PreConfig: deleteOutOfBand,
+				Config:    config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_keys.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("project_status.%s", projectKey1), "in_sync"),
+				),
+			},
+			{
+				// The drifted project is kept in project_keys, so removing it
+				// from the configuration deletes its role.
+				PreConfig: changeOutOfBand,
+				Config:    updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "project_keys.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "project_keys.*", projectKey1),
+					resource.TestCheckResourceAttr(resourceName, "project_status.%", "1"),
+					checkRoleDeleted(projectKey2),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateId:                        fmt.Sprintf("%s:%s", name, projectKey1),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func TestAccProjectRoleTemplate_partial_failure(t *testing.T) {
+	name := fmt.Sprintf("role%s", strings.ToLower(acctest.RandSeq(10)))
+	projectKey := fmt.Sprintf("proja%s", strings.ToLower(acctest.RandSeq(6)))
+	missingProjectKey := fmt.Sprintf("projm%s", strings.ToLower(acctest.RandSeq(6)))
+	resourceName := fmt.Sprintf("project_role_template.%s", name)
+
+	config := util.ExecuteTemplate("TestAccProjectRoleTemplate", `
+		resource "project" "{{ .project_key }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_key }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_role_template" "{{ .name }}" {
+			name         = "{{ .name }}"
+			environments = ["DEV"]
+			actions      = ["READ_REPOSITORY"]
+			project_keys = [project.{{ .project_key }}.key, "{{ .missing_project_key }}"]
+		}
+	`, map[string]interface{}{
+		"name":                name,
+		"project_key":         projectKey,
+		"missing_project_key": missingProjectKey,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The project that does not exist is only a warning, so the resource is
+				// not tainted, and the role is retried in that project on the next apply.
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_keys.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("project_status.%s", projectKey), "in_sync"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
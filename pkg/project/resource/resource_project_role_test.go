@@ -2,6 +2,7 @@ package project_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -244,16 +245,8 @@ func TestAccProjectRole_conflict_with_project(t *testing.T) {
 		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: config,
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(resourceName, "name", testData["name"]),
-					resource.TestCheckResourceAttr(resourceName, "project_key", testData["project_key"]),
-					resource.TestCheckResourceAttr(resourceName, "type", testData["type"]),
-					resource.TestCheckResourceAttr(resourceName, "environments.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "environments.0", testData["environment"]),
-					resource.TestCheckResourceAttr(resourceName, "actions.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "actions.0", testData["action"]),
-				),
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*Conflicting management of project roles.*`),
 			},
 		},
 	})
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -71,10 +70,7 @@ func (r *ProjectShareRepositoryResource) Schema(ctx context.Context, req resourc
 				Optional: true,
 				Computed: true,
 				Default:  booldefault.StaticBool(false),
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
-				Description: "Share repository with a Project in Read-Only mode to avoid any changes or modifications of the shared content.\n\n" +
+				Description: "Share repository with a Project in Read-Only mode to avoid any changes or modifications of the shared content. Changing it re-issues the share with the new mode in place, without unsharing the repository first.\n\n" +
 					"->Only available for Artifactory 7.94.0 or later.",
 			},
 		},
@@ -204,10 +200,38 @@ func (r *ProjectShareRepositoryResource) Read(ctx context.Context, req resource.
 }
 
 func (r *ProjectShareRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddWarning(
-		"Update not supported",
-		"Repository sharing with project cannnot be updated.",
-	)
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	lockName := "share"
+	GlobalMutex.Lock(lockName)
+	defer GlobalMutex.Unlock(lockName)
+
+	var plan ProjectShareRepositoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoKey := plan.RepoKey.ValueString()
+	readOnly := plan.ReadOnly.ValueBool()
+
+	// Sharing again with the new mode keeps the repository shared during the update
+	err := shareRepoWithProject(ctx, repoKey, plan.TargetProjectKey.ValueString(), readOnly, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	err = waitForShareReadOnly(ctx, repoKey, plan.TargetProjectKey.ValueString(), readOnly, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ProjectShareRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
@@ -313,3 +314,103 @@ func TestAccProjectShareRepositoryWithMultipleProjects(t *testing.T) {
 		},
 	})
 }
+
+func TestAccProjectShareRepository_toggle_read_only(t *testing.T) {
+	client := acctest.GetTestResty(t)
+	version, err := util.GetArtifactoryVersion(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid, err := util.CheckVersion(version, "7.94.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid {
+		t.Skipf("Artifactory version %s is earlier than 7.94.0", version)
+	}
+
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+
+	repoKey := fmt.Sprintf("repo%d", testutil.RandomInt())
+
+	_, fqrn, resourceName := testutil.MkNames("test-project-share-repo", "project_share_repository")
+
+	temp := `
+		resource "artifactory_local_generic_repository" "{{ .repo_key }}" {
+			key = "{{ .repo_key }}"
+
+			lifecycle {
+				ignore_changes = ["project_key", "project_environments"]
+			}
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+		}
+
+		resource "project_share_repository" "{{ .resource_name }}" {
+			repo_key           = artifactory_local_generic_repository.{{ .repo_key }}.key
+			target_project_key = project.{{ .project_name }}.key
+			read_only          = {{ .read_only }}
+		}
+	`
+
+	params := map[string]string{
+		"project_name":  projectName,
+		"project_key":   projectKey,
+		"repo_key":      repoKey,
+		"resource_name": resourceName,
+		"read_only":     "false",
+	}
+	config := util.ExecuteTemplate("TestAccProjectShareRepository", temp, params)
+
+	updateParams := map[string]string{
+		"project_name":  projectName,
+		"project_key":   projectKey,
+		"repo_key":      repoKey,
+		"resource_name": resourceName,
+		"read_only":     "true",
+	}
+	configUpdated := util.ExecuteTemplate("TestAccProjectShareRepository", temp, updateParams)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(fqrn, "read_only", "false"),
+			},
+			{
+				Config: configUpdated,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(fqrn, "read_only", "true"),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(fqrn, "read_only", "false"),
+			},
+		},
+	})
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -58,10 +57,7 @@ func (r *ProjectShareRepositoryWithAllResource) Schema(ctx context.Context, req
 				Optional: true,
 				Computed: true,
 				Default:  booldefault.StaticBool(false),
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
-				Description: "Share repository with all Projects in Read-Only mode to avoid any changes or modifications of the shared content.\n\n" +
+				Description: "Share repository with all Projects in Read-Only mode to avoid any changes or modifications of the shared content. Changing it re-issues the share with the new mode in place, without unsharing the repository first.\n\n" +
 					"->Only available for Artifactory 7.94.0 or later.",
 			},
 		},
@@ -182,10 +178,47 @@ func (r *ProjectShareRepositoryWithAllResource) Read(ctx context.Context, req re
 }
 
 func (r *ProjectShareRepositoryWithAllResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddWarning(
-		"Update not supported",
-		"Repository sharing with all projects cannnot be updated.",
-	)
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	lockName := "share"
+	GlobalMutex.Lock(lockName)
+	defer GlobalMutex.Unlock(lockName)
+
+	var plan ProjectShareRepositoryWithAllResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoKey := plan.RepoKey.ValueString()
+	readOnly := plan.ReadOnly.ValueBool()
+
+	// Sharing again with the new mode keeps the repository shared during the update
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetPathParam("repo_key", repoKey).
+		SetQueryParam("readOnly", fmt.Sprintf("%t", readOnly)).
+		SetError(&projectError).
+		Put(shareWithAllProjectsEndpoint)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+	if response.IsError() {
+		utilfw.UnableToUpdateResourceError(resp, projectError.String())
+		return
+	}
+
+	err = waitForShareReadOnly(ctx, repoKey, "", readOnly, r.ProviderData.Client)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ProjectShareRepositoryWithAllResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
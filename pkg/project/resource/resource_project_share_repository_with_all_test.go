@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
@@ -142,3 +143,74 @@ func TestAccProjectShareWithAllRepository_full(t *testing.T) {
 		},
 	})
 }
+
+func TestAccProjectShareWithAllRepository_toggle_read_only(t *testing.T) {
+	client := acctest.GetTestResty(t)
+	version, err := util.GetArtifactoryVersion(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid, err := util.CheckVersion(version, "7.94.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid {
+		t.Skipf("Artifactory version %s is earlier than 7.94.0", version)
+	}
+
+	repoKey := fmt.Sprintf("repo%d", testutil.RandomInt())
+
+	_, fqrn, resourceName := testutil.MkNames("test-project-share-repo", "project_share_repository_with_all")
+
+	temp := `
+		resource "artifactory_local_generic_repository" "{{ .repo_key }}" {
+			key = "{{ .repo_key }}"
+
+			lifecycle {
+				ignore_changes = ["project_key", "project_environments"]
+			}
+		}
+
+		resource "project_share_repository_with_all" "{{ .resource_name }}" {
+			repo_key  = artifactory_local_generic_repository.{{ .repo_key }}.key
+			read_only = {{ .read_only }}
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProjectShareRepository", temp, map[string]string{
+		"repo_key":      repoKey,
+		"resource_name": resourceName,
+		"read_only":     "false",
+	})
+
+	configUpdated := util.ExecuteTemplate("TestAccProjectShareRepository", temp, map[string]string{
+		"repo_key":      repoKey,
+		"resource_name": resourceName,
+		"read_only":     "true",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(fqrn, "read_only", "false"),
+			},
+			{
+				Config: configUpdated,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(fqrn, "read_only", "true"),
+			},
+		},
+	})
+}
@@ -0,0 +1,390 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	"github.com/samber/lo"
+)
+
+func NewProjectShareRepositoryWithProjectsResource() resource.Resource {
+	return &ProjectShareRepositoryWithProjectsResource{
+		TypeName: "project_share_repository_with_projects",
+	}
+}
+
+type ProjectShareRepositoryWithProjectsResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ProjectShareRepositoryWithProjectsResourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	RepoKey                   types.String `tfsdk:"repo_key"`
+	TargetProjectKeys         types.Set    `tfsdk:"target_project_keys"`
+	ReadOnlyTargetProjectKeys types.Set    `tfsdk:"read_only_target_project_keys"`
+}
+
+func (m ProjectShareRepositoryWithProjectsResourceModel) targets(ctx context.Context) ([]string, []string, diag.Diagnostics) {
+	ds := diag.Diagnostics{}
+
+	targetProjectKeys := []string{}
+	if !m.TargetProjectKeys.IsNull() && !m.TargetProjectKeys.IsUnknown() {
+		ds.Append(m.TargetProjectKeys.ElementsAs(ctx, &targetProjectKeys, false)...)
+	}
+
+	readOnlyTargetProjectKeys := []string{}
+	if !m.ReadOnlyTargetProjectKeys.IsNull() && !m.ReadOnlyTargetProjectKeys.IsUnknown() {
+		ds.Append(m.ReadOnlyTargetProjectKeys.ElementsAs(ctx, &readOnlyTargetProjectKeys, false)...)
+	}
+
+	return targetProjectKeys, readOnlyTargetProjectKeys, ds
+}
+
+func (r *ProjectShareRepositoryWithProjectsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ProjectShareRepositoryWithProjectsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repo_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validatorfw_string.RepoKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The key of the repository.",
+			},
+			"target_project_keys": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(validatorfw_string.ProjectKey()),
+				},
+				Description: "The keys of the projects to which the repository should be shared with.",
+			},
+			"read_only_target_project_keys": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validatorfw_string.ProjectKey()),
+				},
+				Description: "The keys of the projects, out of `target_project_keys`, with which the repository is shared in Read-Only mode to avoid any changes or modifications of the shared content. Default to empty set. " +
+					"The API only reports the read-only mode of the repository as a whole, so a change of the mode of one share made outside of Terraform is not detected.\n\n" +
+					"->Only available for Artifactory 7.94.0 or later.",
+			},
+		},
+		Description: "Share a local or remote repository with a set of projects. The current shares are fetched with a single repository status call and only the needed share and unshare calls are issued. " +
+			"Shares with projects not listed in `target_project_keys` are left as is, and existing shares with listed projects are shared again with the configured read-only mode. Project Members of the target projects are granted actions to the shared repository according to their Roles and Role actions assigned in the target Project. Requires a user assigned with the 'Administer the Platform' role.\n\n" +
+			"->Only available for Artifactory 7.90.1 or later.\n\n" +
+			"~>This resource should not be used in combination with `project_share_repository` resources for the same repository and target project.",
+	}
+}
+
+func (r *ProjectShareRepositoryWithProjectsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+
+	supported, err := util.CheckVersion(r.ProviderData.ArtifactoryVersion, "7.90.1")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to check Artifactory version",
+			err.Error(),
+		)
+		return
+	}
+
+	if !supported {
+		resp.Diagnostics.AddError(
+			"Unsupported Artifactory version",
+			fmt.Sprintf("This resource is supported by Artifactory version 7.90.1 or later. Current version: %s", r.ProviderData.ArtifactoryVersion),
+		)
+		return
+	}
+}
+
+// syncShares shares the repository with the planned target projects that it is not shared with yet,
+// shares again in place the ones whose read-only flag changed or is not known, i.e. shared outside of
+// Terraform, and unshares the ones removed from the configuration.
+func (r *ProjectShareRepositoryWithProjectsResource) syncShares(ctx context.Context, plan ProjectShareRepositoryWithProjectsResourceModel, state *ProjectShareRepositoryWithProjectsResourceModel) diag.Diagnostics {
+	ds := diag.Diagnostics{}
+
+	repoKey := plan.RepoKey.ValueString()
+
+	targetProjectKeys, readOnlyTargetProjectKeys, d := plan.targets(ctx)
+	ds.Append(d...)
+
+	previousTargetProjectKeys, previousReadOnlyTargetProjectKeys := []string{}, []string{}
+	if state != nil {
+		previousTargetProjectKeys, previousReadOnlyTargetProjectKeys, d = state.targets(ctx)
+		ds.Append(d...)
+	}
+	if ds.HasError() {
+		return ds
+	}
+
+	status, err := readRepoStatus(ctx, repoKey, r.ProviderData.Client)
+	if err != nil {
+		ds.AddError("Failed to fetch repository status", err.Error())
+		return ds
+	}
+
+	for _, targetProjectKey := range lo.Intersect(lo.Without(previousTargetProjectKeys, targetProjectKeys...), status.SharedWithProjects) {
+		readOnly := lo.Contains(previousReadOnlyTargetProjectKeys, targetProjectKey)
+		if err := unshareRepoWithProject(ctx, repoKey, targetProjectKey, readOnly, r.ProviderData.Client); err != nil {
+			ds.AddError("Failed to unshare repository", err.Error())
+			return ds
+		}
+	}
+
+	for _, targetProjectKey := range targetProjectKeys {
+		readOnly := lo.Contains(readOnlyTargetProjectKeys, targetProjectKey)
+
+		// The read-only mode of a share is only known when it was set by this resource
+		if lo.Contains(status.SharedWithProjects, targetProjectKey) && lo.Contains(previousTargetProjectKeys, targetProjectKey) {
+			previousReadOnly := lo.Contains(previousReadOnlyTargetProjectKeys, targetProjectKey)
+			if previousReadOnly == readOnly {
+				continue
+			}
+		}
+
+		// Existing shares are shared again with the planned mode, so they stay in place while the read-only flag changes
+		if err := shareRepoWithProject(ctx, repoKey, targetProjectKey, readOnly, r.ProviderData.Client); err != nil {
+			ds.AddError("Failed to share repository", err.Error())
+			return ds
+		}
+	}
+
+	return ds
+}
+
+func (r *ProjectShareRepositoryWithProjectsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	lockName := "share"
+	GlobalMutex.Lock(lockName)
+	defer GlobalMutex.Unlock(lockName)
+
+	var plan ProjectShareRepositoryWithProjectsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncShares(ctx, plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.RepoKey
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectShareRepositoryWithProjectsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ProjectShareRepositoryWithProjectsResourceModel
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoKey := state.RepoKey.ValueString()
+
+	var status ProjectRepositoryStatusAPIModel
+	var projectError ProjectErrorsResponse
+	response, err := r.ProviderData.Client.R().
+		SetPathParam("repo_key", repoKey).
+		SetResult(&status).
+		SetError(&projectError).
+		Get(ProjectRepositoryStatusEndpoint)
+
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.AddWarning(
+			"repo not found",
+			repoKey,
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToRefreshResourceError(resp, projectError.String())
+		return
+	}
+
+	stateTargetProjectKeys, stateReadOnlyTargetProjectKeys, ds := state.targets(ctx)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var targetProjectKeys, readOnlyTargetProjectKeys []string
+	if state.TargetProjectKeys.IsNull() {
+		// import, the read-only flag is only reported for the repository as a whole
+		targetProjectKeys = status.SharedWithProjects
+		readOnlyTargetProjectKeys = lo.Ternary(status.SharedReadOnly, targetProjectKeys, []string{})
+	} else {
+		// ignore shares with projects not listed in the configuration
+		targetProjectKeys = lo.Intersect(stateTargetProjectKeys, status.SharedWithProjects)
+		readOnlyTargetProjectKeys = lo.Intersect(stateReadOnlyTargetProjectKeys, targetProjectKeys)
+
+		if unsharedProjectKeys := lo.Without(stateTargetProjectKeys, targetProjectKeys...); len(unsharedProjectKeys) > 0 {
+			resp.Diagnostics.AddWarning(
+				"repo not shared with projects",
+				fmt.Sprintf("%s not shared with %s", repoKey, formatNames(unsharedProjectKeys)),
+			)
+		}
+	}
+
+	targetProjectKeysSet, ds := types.SetValueFrom(ctx, types.StringType, lo.Ternary(targetProjectKeys == nil, []string{}, targetProjectKeys))
+	resp.Diagnostics.Append(ds...)
+	readOnlyTargetProjectKeysSet, ds := types.SetValueFrom(ctx, types.StringType, lo.Ternary(readOnlyTargetProjectKeys == nil, []string{}, readOnlyTargetProjectKeys))
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(repoKey)
+	state.TargetProjectKeys = targetProjectKeysSet
+	state.ReadOnlyTargetProjectKeys = readOnlyTargetProjectKeysSet
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectShareRepositoryWithProjectsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	lockName := "share"
+	GlobalMutex.Lock(lockName)
+	defer GlobalMutex.Unlock(lockName)
+
+	var plan, state ProjectShareRepositoryWithProjectsResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncShares(ctx, plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.RepoKey
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectShareRepositoryWithProjectsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	lockName := "share"
+	GlobalMutex.Lock(lockName)
+	defer GlobalMutex.Unlock(lockName)
+
+	var state ProjectShareRepositoryWithProjectsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repoKey := state.RepoKey.ValueString()
+
+	targetProjectKeys, readOnlyTargetProjectKeys, ds := state.targets(ctx)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, targetProjectKey := range targetProjectKeys {
+		readOnly := lo.Contains(readOnlyTargetProjectKeys, targetProjectKey)
+		if err := unshareRepoWithProject(ctx, repoKey, targetProjectKey, readOnly, r.ProviderData.Client); err != nil {
+			utilfw.UnableToDeleteResourceError(resp, err.Error())
+			return
+		}
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+func (r *ProjectShareRepositoryWithProjectsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ProjectShareRepositoryWithProjectsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values may not be known until apply
+	elements := append(config.TargetProjectKeys.Elements(), config.ReadOnlyTargetProjectKeys.Elements()...)
+	if config.TargetProjectKeys.IsUnknown() || config.ReadOnlyTargetProjectKeys.IsUnknown() || lo.SomeBy(elements, attr.Value.IsUnknown) {
+		return
+	}
+
+	targetProjectKeys, readOnlyTargetProjectKeys, ds := config.targets(ctx)
+	resp.Diagnostics.Append(ds...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if extraProjectKeys := lo.Without(readOnlyTargetProjectKeys, targetProjectKeys...); len(extraProjectKeys) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_only_target_project_keys"),
+			"Invalid Attributes Configuration",
+			fmt.Sprintf("Projects %s are not listed in target_project_keys", formatNames(extraProjectKeys)),
+		)
+	}
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *ProjectShareRepositoryWithProjectsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("repo_key"), req, resp)
+}
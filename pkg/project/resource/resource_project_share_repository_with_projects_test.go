@@ -0,0 +1,155 @@
+package project_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const projectShareRepositoryWithProjectsTemplate = `
+	resource "artifactory_local_generic_repository" "{{ .repo_key }}" {
+		key = "{{ .repo_key }}"
+
+		lifecycle {
+			ignore_changes = ["project_key", "project_environments"]
+		}
+	}
+
+	{{ range .project_keys }}
+	resource "project" "{{ . }}" {
+		key          = "{{ . }}"
+		display_name = "tftestprojects{{ . }}"
+		admin_privileges {
+			manage_members   = true
+			manage_resources = true
+			index_resources  = true
+		}
+	}
+	{{ end }}
+
+	resource "project_share_repository_with_projects" "{{ .repo_key }}" {
+		repo_key                      = artifactory_local_generic_repository.{{ .repo_key }}.key
+		target_project_keys           = [{{ range .target_project_keys }}project.{{ . }}.key, {{ end }}]
+		read_only_target_project_keys = [{{ range .read_only_target_project_keys }}project.{{ . }}.key, {{ end }}]
+	}
+`
+
+func TestAccProjectShareRepositoryWithProjects_full(t *testing.T) {
+	client := acctest.GetTestResty(t)
+	version, err := util.GetArtifactoryVersion(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid, err := util.CheckVersion(version, "7.94.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid {
+		t.Skipf("Artifactory version %s is earlier than 7.94.0", version)
+	}
+
+	projectKey1 := strings.ToLower(acctest.RandSeq(10))
+	projectKey2 := strings.ToLower(acctest.RandSeq(10))
+	projectKey3 := strings.ToLower(acctest.RandSeq(10))
+	projectKeys := []string{projectKey1, projectKey2, projectKey3}
+
+	repoKey := fmt.Sprintf("repo%d", testutil.RandomInt())
+
+	resourceName := fmt.Sprintf("project_share_repository_with_projects.%s", repoKey)
+
+	config := util.ExecuteTemplate("TestAccProjectShareRepositoryWithProjects", projectShareRepositoryWithProjectsTemplate, map[string]interface{}{
+		"repo_key":                      repoKey,
+		"project_keys":                  projectKeys,
+		"target_project_keys":           []string{projectKey1, projectKey2},
+		"read_only_target_project_keys": []string{projectKey2},
+	})
+
+	configUpdated := util.ExecuteTemplate("TestAccProjectShareRepositoryWithProjects", projectShareRepositoryWithProjectsTemplate, map[string]interface{}{
+		"repo_key":                      repoKey,
+		"project_keys":                  projectKeys,
+		"target_project_keys":           []string{projectKey2, projectKey3},
+		"read_only_target_project_keys": []string{},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", repoKey),
+					resource.TestCheckResourceAttr(resourceName, "repo_key", repoKey),
+					resource.TestCheckResourceAttr(resourceName, "target_project_keys.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "target_project_keys.*", projectKey1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "target_project_keys.*", projectKey2),
+					resource.TestCheckResourceAttr(resourceName, "read_only_target_project_keys.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "read_only_target_project_keys.*", projectKey2),
+				),
+			},
+			{
+				Config: configUpdated,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target_project_keys.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "target_project_keys.*", projectKey2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "target_project_keys.*", projectKey3),
+					resource.TestCheckResourceAttr(resourceName, "read_only_target_project_keys.#", "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateId:                        repoKey,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "repo_key",
+			},
+		},
+	})
+}
+
+func TestAccProjectShareRepositoryWithProjects_invalid_read_only(t *testing.T) {
+	projectKey1 := strings.ToLower(acctest.RandSeq(10))
+	projectKey2 := strings.ToLower(acctest.RandSeq(10))
+
+	repoKey := fmt.Sprintf("repo%d", testutil.RandomInt())
+
+	config := util.ExecuteTemplate("TestAccProjectShareRepositoryWithProjects", `
+		resource "project_share_repository_with_projects" "{{ .repo_key }}" {
+			repo_key                      = "{{ .repo_key }}"
+			target_project_keys           = ["{{ .project_key_1 }}"]
+			read_only_target_project_keys = ["{{ .project_key_2 }}"]
+		}
+	`, map[string]interface{}{
+		"repo_key":      repoKey,
+		"project_key_1": projectKey1,
+		"project_key_2": projectKey2,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*are not listed in target_project_keys.*`),
+			},
+		},
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"regexp"
+	"slices"
 	"strings"
 	"testing"
 
@@ -1173,3 +1174,404 @@ func TestAccProject_migrate_schema(t *testing.T) {
 		},
 	})
 }
+
+func TestAccProject_force_destroy(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	repoKey := fmt.Sprintf("repo%d", testutil.RandomInt())
+	envName := strings.ToLower(acctest.RandSeq(8))
+	// a global environment named like a project environment, which must not be deleted with the project
+	globalEnvName := fmt.Sprintf("%s-%s", projectKey, strings.ToLower(acctest.RandSeq(8)))
+
+	params := map[string]interface{}{
+		"name":        name,
+		"project_key": projectKey,
+		"repo_key":    repoKey,
+	}
+
+	config := util.ExecuteTemplate("TestAccProjectForceDestroy", `
+		resource "artifactory_local_generic_repository" "{{ .repo_key }}" {
+			key = "{{ .repo_key }}"
+
+			lifecycle {
+				ignore_changes = ["project_key", "project_environments"]
+			}
+		}
+
+		resource "project" "{{ .name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+			force_destroy = true
+
+			// destroy the project while the repository still exists
+			depends_on = [artifactory_local_generic_repository.{{ .repo_key }}]
+		}
+	`, params)
+
+	// assign a repository and create an environment outside of Terraform
+	addUnmanagedResources := func(_ *terraform.State) error {
+		client := acctest.GetTestResty(t)
+
+		resp, err := client.R().
+			SetPathParams(map[string]string{
+				"projectKey": projectKey,
+				"repoKey":    repoKey,
+			}).
+			SetQueryParam("force", "true").
+			Put("/access/api/v1/projects/_/attach/repositories/{repoKey}/{projectKey}")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return fmt.Errorf("failed to assign repo %s: %s", repoKey, resp.String())
+		}
+
+		resp, err = client.R().
+			SetPathParam("projectKey", projectKey).
+			SetBody(project.ProjectEnvironmentAPIModel{
+				Name: fmt.Sprintf("%s-%s", projectKey, envName),
+			}).
+			Post(project.ProjectEnvironmentUrl)
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return fmt.Errorf("failed to create environment: %s", resp.String())
+		}
+
+		resp, err = client.R().
+			SetBody(project.ProjectEnvironmentAPIModel{
+				Name: globalEnvName,
+			}).
+			Post(project.GlobalEnvironmentUrl)
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return fmt.Errorf("failed to create global environment: %s", resp.String())
+		}
+
+		return nil
+	}
+
+	// the global environment is kept, then cleaned up
+	checkGlobalEnvironmentKept := func(_ *terraform.State) error {
+		client := acctest.GetTestResty(t)
+
+		var envs []project.ProjectEnvironmentAPIModel
+		resp, err := client.R().
+			SetResult(&envs).
+			Get(project.GlobalEnvironmentUrl)
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return fmt.Errorf("failed to read global environments: %s", resp.String())
+		}
+
+		if !slices.ContainsFunc(envs, func(env project.ProjectEnvironmentAPIModel) bool {
+			return env.Name == globalEnvName
+		}) {
+			return fmt.Errorf("global environment %s was deleted with project %s", globalEnvName, projectKey)
+		}
+
+		resp, err = client.R().
+			SetPathParam("environmentName", globalEnvName).
+			Delete(project.GlobalEnvironmentUrl + "/{environmentName}")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return fmt.Errorf("failed to delete global environment: %s", resp.String())
+		}
+
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			acctest.VerifyDeleted(resourceName, verifyProject),
+			checkGlobalEnvironmentKept,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "true"),
+					addUnmanagedResources,
+					testAccCheckProjectRepoCount(t, projectKey, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProject_deletion_protection(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+	key1 := strings.ToLower(acctest.RandSeq(10))
+	key2 := strings.ToLower(acctest.RandSeq(10))
+
+	template := `
+		resource "project" "{{ .name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+			deletion_protection = {{ .deletion_protection }}
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProjectDeletionProtection", template, map[string]interface{}{
+		"name":                name,
+		"project_key":         key1,
+		"deletion_protection": true,
+	})
+
+	configWithNewKey := util.ExecuteTemplate("TestAccProjectDeletionProtection", template, map[string]interface{}{
+		"name":                name,
+		"project_key":         key2,
+		"deletion_protection": true,
+	})
+
+	configUnprotected := util.ExecuteTemplate("TestAccProjectDeletionProtection", template, map[string]interface{}{
+		"name":                name,
+		"project_key":         key1,
+		"deletion_protection": false,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      configWithNewKey,
+				ExpectError: regexp.MustCompile(`.*Project is protected from deletion.*`),
+			},
+			{
+				Config:      config,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*Project is protected from deletion.*`),
+			},
+			{
+				Config: configUnprotected,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProject_storage_usage(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	template := `
+		resource "project" "{{ .name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+			max_storage_in_gibibytes = {{ .max_storage_in_gibibytes }}
+			track_storage_usage      = {{ .track_storage_usage }}
+		}
+	`
+
+	untrackedConfig := util.ExecuteTemplate("TestAccProjectStorageUsage", template, map[string]interface{}{
+		"name":                     name,
+		"project_key":              projectKey,
+		"max_storage_in_gibibytes": 2,
+		"track_storage_usage":      false,
+	})
+
+	config := util.ExecuteTemplate("TestAccProjectStorageUsage", template, map[string]interface{}{
+		"name":                     name,
+		"project_key":              projectKey,
+		"max_storage_in_gibibytes": 2,
+		"track_storage_usage":      true,
+	})
+
+	configUpdated := util.ExecuteTemplate("TestAccProjectStorageUsage", template, map[string]interface{}{
+		"name":                     name,
+		"project_key":              projectKey,
+		"max_storage_in_gibibytes": 1,
+		"track_storage_usage":      true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The storage summary is not read unless tracking is turned on
+				Config: untrackedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "track_storage_usage", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "used_storage_bytes"),
+					resource.TestCheckNoResourceAttr(resourceName, "used_storage_percent"),
+					resource.TestCheckNoResourceAttr(resourceName, "quota_exceeded"),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "used_storage_bytes", "0"),
+					resource.TestCheckResourceAttr(resourceName, "used_storage_percent", "0"),
+					resource.TestCheckResourceAttr(resourceName, "quota_exceeded", "false"),
+				),
+			},
+			{
+				Config: configUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_storage_in_gibibytes", "1"),
+					resource.TestCheckResourceAttr(resourceName, "used_storage_bytes", "0"),
+					resource.TestCheckResourceAttr(resourceName, "quota_exceeded", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProject_max_storage(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	resourceName := fmt.Sprintf("project.%s", name)
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	template := `
+		resource "project" "{{ .name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+			max_storage = "{{ .max_storage }}"
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProjectMaxStorage", template, map[string]interface{}{
+		"name":        name,
+		"project_key": projectKey,
+		"max_storage": "1.5GiB",
+	})
+
+	configBytes := util.ExecuteTemplate("TestAccProjectMaxStorage", template, map[string]interface{}{
+		"name":        name,
+		"project_key": projectKey,
+		"max_storage": "2147483649",
+	})
+
+	checkStorageQuota := func(expectedBytes int64) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			var proj project.ProjectAPIModel
+			resp, err := acctest.GetTestResty(t).R().
+				SetPathParam("projectKey", projectKey).
+				SetResult(&proj).
+				Get(project.ProjectUrl)
+			if err != nil {
+				return err
+			}
+			if resp.IsError() {
+				return fmt.Errorf("%s", resp.String())
+			}
+			if proj.StorageQuota != expectedBytes {
+				return fmt.Errorf("expected storage quota of %d bytes, got %d", expectedBytes, proj.StorageQuota)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(resourceName, verifyProject),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_storage", "1.5GiB"),
+					resource.TestCheckResourceAttr(resourceName, "max_storage_in_gibibytes", "1"),
+					checkStorageQuota(1610612736),
+				),
+			},
+			{
+				Config: configBytes,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_storage", "2147483649"),
+					resource.TestCheckResourceAttr(resourceName, "max_storage_in_gibibytes", "2"),
+					checkStorageQuota(2147483649),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateId:           projectKey,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"max_storage"},
+			},
+		},
+	})
+}
+
+func TestAccProject_max_storage_conflict(t *testing.T) {
+	name := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	config := util.ExecuteTemplate("TestAccProjectMaxStorage", `
+		resource "project" "{{ .name }}" {
+			key          = "{{ .project_key }}"
+			display_name = "{{ .name }}"
+			admin_privileges {
+				manage_members   = true
+				manage_resources = true
+				index_resources  = true
+			}
+			max_storage              = "500GiB"
+			max_storage_in_gibibytes = 500
+		}
+	`, map[string]interface{}{
+		"name":        name,
+		"project_key": projectKey,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination.*`),
+			},
+		},
+	})
+}
@@ -23,6 +23,11 @@ import (
 
 const ProjectUsersUrl = "access/api/v1/projects/{projectKey}/users/{name}"
 
+const (
+	membershipStatusActive  = "active"
+	membershipStatusPending = "pending"
+)
+
 func NewProjectUserResource() resource.Resource {
 	return &ProjectUserResource{
 		TypeName: "project_user",
@@ -40,6 +45,7 @@ type ProjectUserResourceModel struct {
 	ProjectKey        types.String `tfsdk:"project_key"`
 	Roles             types.Set    `tfsdk:"roles"`
 	IgnoreMissingUser types.Bool   `tfsdk:"ignore_missing_user"`
+	MembershipStatus  types.String `tfsdk:"membership_status"`
 }
 
 type ProjectUserAPIModel struct {
@@ -84,7 +90,7 @@ func (r *ProjectUserResource) Schema(ctx context.Context, req resource.SchemaReq
 				Validators: []validator.Set{
 					setvalidator.SizeAtLeast(1),
 				},
-				Description: "List of pre-defined Project or custom roles. Must have at least 1 role, e.g. 'Viewer'",
+				Description: "List of pre-defined Project or custom roles. Must have at least 1 role, e.g. 'Viewer'. Roles that do not exist in the project fail the plan, or the apply when the project is created in the same apply. Reference roles created by `project_role` by name, e.g. `project_role.<name>.name`, so they are created first.",
 			},
 			"ignore_missing_user": schema.BoolAttribute{
 				Optional:    true,
@@ -92,6 +98,10 @@ func (r *ProjectUserResource) Schema(ctx context.Context, req resource.SchemaReq
 				Default:     booldefault.StaticBool(false),
 				Description: "When set to `true`, the resource will not fail if the user does not exist. Default to `false`. This is useful when the user is externally managed and the local account wasn't created yet.",
 			},
+			"membership_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the project membership: `active`, or `pending` when the user does not exist yet and `ignore_missing_user` is set. A pending membership is checked on every plan, and created by the next apply once the user exists.",
+			},
 		},
 		Description: "Add a user as project member. Element has one to one mapping with the [JFrog Project Users API](https://jfrog.com/help/r/jfrog-rest-apis/add-or-update-user-in-project). Requires a user assigned with the 'Administer the Platform' role or Project Admin permissions if `admin_privileges.manage_resoures` is enabled.",
 	}
@@ -124,6 +134,12 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// The API accepts unknown roles but silently drops them from the assignment
+	if err := checkRolesExist(ctx, projectKey, roles, nil, r.ProviderData.Client); err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
 	user := ProjectUserAPIModel{
 		Name:  plan.Name.ValueString(),
 		Roles: roles,
@@ -140,6 +156,7 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 		Put(ProjectUsersUrl)
 	if err != nil {
 		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
 	}
 	if response.StatusCode() == http.StatusNotFound {
 		if plan.IgnoreMissingUser.ValueBool() {
@@ -147,6 +164,7 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 				fmt.Sprintf("user '%s' not found", user.Name),
 				"but ignore_missing_user is set to true, project membership not created",
 			)
+			plan.MembershipStatus = types.StringValue(membershipStatusPending)
 		} else {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("user '%s' not found", user.Name),
@@ -158,6 +176,9 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 		utilfw.UnableToCreateResourceError(resp, projectError.String())
 	}
 
+	if plan.MembershipStatus.IsUnknown() {
+		plan.MembershipStatus = types.StringValue(membershipStatusActive)
+	}
 	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", projectKey, user.Name))
 
 	// Save data into Terraform state
@@ -192,6 +213,17 @@ func (r *ProjectUserResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	if response.StatusCode() == http.StatusNotFound {
+		if state.IgnoreMissingUser.ValueBool() {
+			// keep the membership in state as pending, the plan performs the update once the user exists
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("user '%s' not found", state.Name.ValueString()),
+				"but ignore_missing_user is set to true, project membership is pending until the user exists",
+			)
+			state.MembershipStatus = types.StringValue(membershipStatusPending)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+
 		// on read always ensure the resource is not part of the state if user or project_user are missing
 		// this will ensure its detected as deleted and re-created on plan/apply
 		resp.State.RemoveResource(ctx)
@@ -214,6 +246,7 @@ func (r *ProjectUserResource) Read(ctx context.Context, req resource.ReadRequest
 	if state.IgnoreMissingUser.IsNull() {
 		state.IgnoreMissingUser = types.BoolValue(false)
 	}
+	state.MembershipStatus = types.StringValue(membershipStatusActive)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -238,6 +271,12 @@ func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// The API accepts unknown roles but silently drops them from the assignment
+	if err := checkRolesExist(ctx, projectKey, roles, nil, r.ProviderData.Client); err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
 	user := ProjectUserAPIModel{
 		Name:  plan.Name.ValueString(),
 		Roles: roles,
@@ -254,6 +293,7 @@ func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateReq
 		Put(ProjectUsersUrl)
 	if err != nil {
 		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
 	}
 	if response.StatusCode() == http.StatusNotFound {
 		if plan.IgnoreMissingUser.ValueBool() {
@@ -261,6 +301,7 @@ func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateReq
 				fmt.Sprintf("user '%s' not found", user.Name),
 				"but ignore_missing_user is set to true, project membership not updated",
 			)
+			plan.MembershipStatus = types.StringValue(membershipStatusPending)
 		} else {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("user '%s' not found", user.Name),
@@ -272,6 +313,9 @@ func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateReq
 		utilfw.UnableToUpdateResourceError(resp, projectError.String())
 	}
 
+	if plan.MembershipStatus.IsUnknown() {
+		plan.MembershipStatus = types.StringValue(membershipStatusActive)
+	}
 	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", projectKey, user.Name))
 
 	// Save data into Terraform state
@@ -312,6 +356,54 @@ func (r *ProjectUserResource) Delete(ctx context.Context, req resource.DeleteReq
 	// the resource from state if there are no other errors.
 }
 
+func (r *ProjectUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Release the claim of the prior state, the resource may be destroyed, or moved to another
+	// project or name. The planned claim is registered again below.
+	if !req.State.Raw.IsNull() {
+		var state ProjectUserResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		managementRegistryFor(r.ProviderData.Client).ReleaseStandalone(state.ProjectKey.ValueString(), usersManagement.Kind, state.Name.ValueString())
+	}
+
+	// Nothing to check when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ProjectKey.IsUnknown() || plan.Name.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkStandaloneClaim(plan.ProjectKey.ValueString(), usersManagement, plan.Name.ValueString(), r.ProviderData.Client)...)
+	resp.Diagnostics.Append(checkPlannedRoles(ctx, plan.ProjectKey.ValueString(), plan.Roles, r.ProviderData.Client)...)
+
+	// A pending membership is created by the next apply once the user exists
+	if plan.MembershipStatus.ValueString() == membershipStatusPending {
+		exists, err := userExists(ctx, plan.Name.ValueString(), r.ProviderData.Client)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("failed to check user '%s'", plan.Name.ValueString()),
+				err.Error(),
+			)
+			return
+		}
+
+		if exists {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("membership_status"), types.StringUnknown())...)
+		}
+	}
+}
+
 // ImportState imports the resource into the Terraform state.
 func (r *ProjectUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.SplitN(req.ID, ":", 2)
@@ -217,6 +217,7 @@ func TestAccProjectUser_full(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "project_key", fmt.Sprintf("%s", params["project_key"])),
 					resource.TestCheckResourceAttr(resourceName, "name", username),
 					resource.TestCheckResourceAttr(resourceName, "ignore_missing_user", "false"),
+					resource.TestCheckResourceAttr(resourceName, "membership_status", "active"),
 					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "roles.0", "Developer"),
 					resource.TestCheckResourceAttr(resourceName, "roles.1", "Project Admin"),
@@ -228,6 +229,7 @@ func TestAccProjectUser_full(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "project_key", fmt.Sprintf("%s", params["project_key"])),
 					resource.TestCheckResourceAttr(resourceName, "name", username),
 					resource.TestCheckResourceAttr(resourceName, "ignore_missing_user", "false"),
+					resource.TestCheckResourceAttr(resourceName, "membership_status", "active"),
 					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "roles.0", "Developer"),
 				),
@@ -305,6 +307,323 @@ func TestAccProjectUser_invalid_roles(t *testing.T) {
 	})
 }
 
+func TestAccProjectUser_unknown_role(t *testing.T) {
+	projectName := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	username := fmt.Sprintf("user%s", strings.ToLower(acctest.RandSeq(5)))
+	email := username + "@tempurl.org"
+
+	params := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"username":     username,
+		"email":        email,
+	}
+
+	projectTemplate := `
+		resource "artifactory_managed_user" "{{ .username }}" {
+			name     = "{{ .username }}"
+			email    = "{{ .email }}"
+			password = "Password1!"
+			admin    = false
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			description = "test description"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+
+			use_project_user_resource = true
+		}
+	`
+
+	userTemplate := projectTemplate + `
+		resource "project_user" "{{ .username }}" {
+			project_key = project.{{ .project_name }}.key
+			name = artifactory_managed_user.{{ .username }}.name
+			roles = ["Developer", "non-existent-role"]
+		}
+	`
+
+	projectConfig := util.ExecuteTemplate("TestAccProjectUser", projectTemplate, params)
+	userConfig := util.ExecuteTemplate("TestAccProjectUser", userTemplate, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: projectConfig,
+			},
+			{
+				// The project exists, so the unknown role is reported at plan time
+				Config:      userConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`.*roles non-existent-role do not exist in project.*`),
+			},
+		},
+	})
+}
+
+func TestAccProjectUser_role_created_in_same_apply(t *testing.T) {
+	projectName := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	username := fmt.Sprintf("user%s", strings.ToLower(acctest.RandSeq(5)))
+	email := username + "@tempurl.org"
+	roleName := fmt.Sprintf("role%s", strings.ToLower(acctest.RandSeq(5)))
+
+	params := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"username":     username,
+		"email":        email,
+		"role_name":    roleName,
+	}
+
+	projectTemplate := `
+		resource "artifactory_managed_user" "{{ .username }}" {
+			name     = "{{ .username }}"
+			email    = "{{ .email }}"
+			password = "Password1!"
+			admin    = false
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			description = "test description"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+
+			use_project_user_resource = true
+		}
+	`
+
+	// The user refers to the role through the `project_role`, so the role is created first
+	userTemplate := projectTemplate + `
+		resource "project_role" "{{ .role_name }}" {
+			name = "{{ .role_name }}"
+			type = "CUSTOM"
+			project_key = project.{{ .project_name }}.key
+
+			environments = ["DEV"]
+			actions = ["READ_REPOSITORY"]
+		}
+
+		resource "project_user" "{{ .username }}" {
+			project_key = project.{{ .project_name }}.key
+			name = artifactory_managed_user.{{ .username }}.name
+			roles = [project_role.{{ .role_name }}.name]
+		}
+	`
+
+	// The literal role name does not exist when the plan is made
+	literalUserTemplate := strings.ReplaceAll(userTemplate, "project_role.{{ .role_name }}.name", `"{{ .role_name }}"`)
+
+	projectConfig := util.ExecuteTemplate("TestAccProjectUser", projectTemplate, params)
+	userConfig := util.ExecuteTemplate("TestAccProjectUser", userTemplate, params)
+	literalUserConfig := util.ExecuteTemplate("TestAccProjectUser", literalUserTemplate, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: projectConfig,
+			},
+			{
+				Config:      literalUserConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`.*roles ` + roleName + ` do not exist in project.*`),
+			},
+			{
+				Config: userConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("project_user.%s", username), "roles.#", "1"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("project_user.%s", username), "roles.0", roleName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectUser_conflict_with_project(t *testing.T) {
+	projectName := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+
+	username := fmt.Sprintf("user%s", strings.ToLower(acctest.RandSeq(5)))
+	email := username + "@tempurl.org"
+
+	params := map[string]interface{}{
+		"project_name": projectName,
+		"project_key":  projectKey,
+		"username":     username,
+		"email":        email,
+	}
+
+	template := `
+		resource "artifactory_managed_user" "{{ .username }}" {
+			name     = "{{ .username }}"
+			email    = "{{ .email }}"
+			password = "Password1!"
+			admin    = false
+		}
+
+		resource "project" "{{ .project_name }}" {
+			key = "{{ .project_key }}"
+			display_name = "{{ .project_name }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+
+			use_project_user_resource = false
+		}
+
+		resource "project_user" "{{ .username }}" {
+			project_key = project.{{ .project_name }}.key
+			name = artifactory_managed_user.{{ .username }}.name
+			roles = ["Developer"]
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccProjectUser", template, params)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*Conflicting management of project users.*`),
+			},
+		},
+	})
+}
+
+func TestAccProjectUser_moved_to_another_project(t *testing.T) {
+	projectName1 := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey1 := strings.ToLower(acctest.RandSeq(10))
+	projectName2 := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
+	projectKey2 := strings.ToLower(acctest.RandSeq(10))
+
+	username := fmt.Sprintf("user%s", strings.ToLower(acctest.RandSeq(5)))
+	email := username + "@tempurl.org"
+
+	template := `
+		resource "artifactory_managed_user" "{{ .username }}" {
+			name     = "{{ .username }}"
+			email    = "{{ .email }}"
+			password = "Password1!"
+			admin    = false
+		}
+
+		resource "project" "{{ .project_name1 }}" {
+			key = "{{ .project_key1 }}"
+			display_name = "{{ .project_name1 }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+
+			use_project_user_resource = {{ .use_project_user_resource }}
+			{{ if .depends_on_user }}depends_on = [project_user.{{ .username }}]{{ end }}
+		}
+
+		resource "project" "{{ .project_name2 }}" {
+			key = "{{ .project_key2 }}"
+			display_name = "{{ .project_name2 }}"
+			admin_privileges {
+				manage_members = true
+				manage_resources = true
+				index_resources = true
+			}
+		}
+
+		resource "project_user" "{{ .username }}" {
+			project_key = project.{{ .user_project_name }}.key
+			name = artifactory_managed_user.{{ .username }}.name
+			roles = ["Developer"]
+		}
+	`
+
+	params := map[string]interface{}{
+		"project_name1":             projectName1,
+		"project_key1":              projectKey1,
+		"project_name2":             projectName2,
+		"project_key2":              projectKey2,
+		"username":                  username,
+		"email":                     email,
+		"use_project_user_resource": true,
+		"depends_on_user":           false,
+		"user_project_name":         projectName1,
+	}
+	config := util.ExecuteTemplate("TestAccProjectUser", template, params)
+
+	// The user moves to the second project while the first one manages its members with
+	// `member` blocks. The user is planned first, and must release its claim on the first project.
+	movedParams := map[string]interface{}{
+		"project_name1":             projectName1,
+		"project_key1":              projectKey1,
+		"project_name2":             projectName2,
+		"project_key2":              projectKey2,
+		"username":                  username,
+		"email":                     email,
+		"use_project_user_resource": false,
+		"depends_on_user":           true,
+		"user_project_name":         projectName2,
+	}
+	movedConfig := util.ExecuteTemplate("TestAccProjectUser", template, movedParams)
+
+	resourceName := fmt.Sprintf("project_user.%s", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(resourceName, "project_key", projectKey1),
+			},
+			{
+				Config: movedConfig,
+				Check:  resource.TestCheckResourceAttr(resourceName, "project_key", projectKey2),
+			},
+		},
+	})
+}
+
 func TestAccProjectUser_missing_user_fails(t *testing.T) {
 	projectName := fmt.Sprintf("tftestprojects%s", acctest.RandSeq(10))
 	projectKey := strings.ToLower(acctest.RandSeq(10))
@@ -445,76 +764,71 @@ func TestAccProjectMember_missing_user_ignored(t *testing.T) {
 				Config: config,
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PostApplyPostRefresh: []plancheck.PlanCheck{
-						// expect create of project user
-						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+						// membership is pending, nothing to do until the user exists
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
 					},
 				},
-				ExpectNonEmptyPlan: true,
-				// expect user to be added to state
+				// expect user to be added to state as pending
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "project_key", fmt.Sprintf("%s", params["project_key"])),
 					resource.TestCheckResourceAttr(resourceName, "name", username),
 					resource.TestCheckResourceAttr(resourceName, "ignore_missing_user", "true"),
+					resource.TestCheckResourceAttr(resourceName, "membership_status", "pending"),
 					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "roles.0", "Developer"),
 					resource.TestCheckResourceAttr(resourceName, "roles.1", "Project Admin"),
 				)},
-			// re-attempt create, will still not work
+			// re-apply, user still missing, no action should be performed
 			{
 				Config: config,
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PostApplyPostRefresh: []plancheck.PlanCheck{
-						// again expect create of project user (refresh will mark project user as missing)
-						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
 					},
 				},
-				ExpectNonEmptyPlan: true,
-				// expect user to be in the state
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "project_key", fmt.Sprintf("%s", params["project_key"])),
 					resource.TestCheckResourceAttr(resourceName, "name", username),
 					resource.TestCheckResourceAttr(resourceName, "ignore_missing_user", "true"),
+					resource.TestCheckResourceAttr(resourceName, "membership_status", "pending"),
 					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "roles.0", "Developer"),
 					resource.TestCheckResourceAttr(resourceName, "roles.1", "Project Admin"),
 				)},
-			// re-attempt create with user being added, will work
+			// user is created, refresh finds the pending membership and plans an update
 			{
 				Config: configUpdated,
 				ConfigPlanChecks: resource.ConfigPlanChecks{
-					PreApply: []plancheck.PlanCheck{
-						// again expect create of project user (refresh will mark project user as missing)
-						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
-					},
 					PostApplyPostRefresh: []plancheck.PlanCheck{
-						// again expect create of project user (refresh will mark project user as missing)
-						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
 					},
 				},
-				// expect user to be in the state
+				ExpectNonEmptyPlan: true,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "project_key", fmt.Sprintf("%s", params["project_key"])),
 					resource.TestCheckResourceAttr(resourceName, "name", username),
 					resource.TestCheckResourceAttr(resourceName, "ignore_missing_user", "true"),
+					resource.TestCheckResourceAttr(resourceName, "membership_status", "pending"),
 					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "roles.0", "Developer"),
 					resource.TestCheckResourceAttr(resourceName, "roles.1", "Project Admin"),
 				)},
-
-			// now user is there, no action should be performed
+			// membership is created, no further action should be performed
 			{
 				Config: configUpdated,
 				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
 					PostApplyPostRefresh: []plancheck.PlanCheck{
-						// again expect create of project user (refresh will mark project user as missing)
 						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
 					},
 				},
-				// expect user to be in the state
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "project_key", fmt.Sprintf("%s", params["project_key"])),
 					resource.TestCheckResourceAttr(resourceName, "name", username),
 					resource.TestCheckResourceAttr(resourceName, "ignore_missing_user", "true"),
+					resource.TestCheckResourceAttr(resourceName, "membership_status", "active"),
 					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "roles.0", "Developer"),
 					resource.TestCheckResourceAttr(resourceName, "roles.1", "Project Admin"),
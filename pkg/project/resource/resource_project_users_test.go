@@ -0,0 +1,212 @@
+package project_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	acctest "github.com/jfrog/terraform-provider-project/pkg/project/acctest"
+	project "github.com/jfrog/terraform-provider-project/pkg/project/resource"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const projectUsersTemplate = `
+	resource "artifactory_managed_user" "{{ .username1 }}" {
+		name     = "{{ .username1 }}"
+		email    = "{{ .username1 }}@tempurl.org"
+		password = "Password!123"
+	}
+
+	resource "artifactory_managed_user" "{{ .username2 }}" {
+		name     = "{{ .username2 }}"
+		email    = "{{ .username2 }}@tempurl.org"
+		password = "Password!123"
+	}
+
+	resource "artifactory_managed_user" "{{ .username3 }}" {
+		name     = "{{ .username3 }}"
+		email    = "{{ .username3 }}@tempurl.org"
+		password = "Password!123"
+	}
+
+	resource "project" "{{ .project_name }}" {
+		key          = "{{ .project_key }}"
+		display_name = "{{ .project_name }}"
+		admin_privileges {
+			manage_members   = true
+			manage_resources = true
+			index_resources  = true
+		}
+	}
+
+	resource "project_users" "{{ .project_key }}" {
+		project_key   = project.{{ .project_name }}.key
+		authoritative = {{ .authoritative }}
+
+		users = [
+			{{- range $name, $roles := .users }}
+			{
+				name  = artifactory_managed_user.{{ $name }}.name
+				roles = [{{ range $roles }}"{{ . }}", {{ end }}]
+			},
+			{{- end }}
+		]
+	}
+`
+
+func TestAccProjectUsers_full(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+
+	username1 := fmt.Sprintf("user1%s", strings.ToLower(acctest.RandSeq(5)))
+	username2 := fmt.Sprintf("user2%s", strings.ToLower(acctest.RandSeq(5)))
+	username3 := fmt.Sprintf("user3%s", strings.ToLower(acctest.RandSeq(5)))
+
+	resourceName := fmt.Sprintf("project_users.%s", projectKey)
+
+	params := map[string]interface{}{
+		"project_name":  projectName,
+		"project_key":   projectKey,
+		"username1":     username1,
+		"username2":     username2,
+		"username3":     username3,
+		"authoritative": true,
+		"users": map[string][]string{
+			username1: {"Developer"},
+			username2: {"Viewer"},
+		},
+	}
+	config := util.ExecuteTemplate("TestAccProjectUsers", projectUsersTemplate, params)
+
+	updateParams := map[string]interface{}{
+		"project_name":  projectName,
+		"project_key":   projectKey,
+		"username1":     username1,
+		"username2":     username2,
+		"username3":     username3,
+		"authoritative": true,
+		"users": map[string][]string{
+			username1: {"Developer", "Viewer"},
+			username3: {"Viewer"},
+		},
+	}
+	configUpdated := util.ExecuteTemplate("TestAccProjectUsers", projectUsersTemplate, updateParams)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", projectKey),
+					resource.TestCheckResourceAttr(resourceName, "project_key", projectKey),
+					resource.TestCheckResourceAttr(resourceName, "authoritative", "true"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*.name", username1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*.name", username2),
+				),
+			},
+			{
+				Config: configUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*.name", username1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*.name", username3),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*.roles.*", "Developer"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "users.*.roles.*", "Viewer"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateId:                        projectKey,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_key",
+			},
+		},
+	})
+}
+
+func TestAccProjectUsers_non_authoritative(t *testing.T) {
+	projectKey := strings.ToLower(acctest.RandSeq(10))
+	projectName := fmt.Sprintf("tftestprojects%s", projectKey)
+
+	username1 := fmt.Sprintf("user1%s", strings.ToLower(acctest.RandSeq(5)))
+	username2 := fmt.Sprintf("user2%s", strings.ToLower(acctest.RandSeq(5)))
+	username3 := fmt.Sprintf("user3%s", strings.ToLower(acctest.RandSeq(5)))
+
+	resourceName := fmt.Sprintf("project_users.%s", projectKey)
+
+	params := map[string]interface{}{
+		"project_name":  projectName,
+		"project_key":   projectKey,
+		"username1":     username1,
+		"username2":     username2,
+		"username3":     username3,
+		"authoritative": false,
+		"users": map[string][]string{
+			username1: {"Developer"},
+			username2: {"Viewer"},
+		},
+	}
+	config := util.ExecuteTemplate("TestAccProjectUsers", projectUsersTemplate, params)
+
+	// add the 3rd user outside of this resource
+	addUser := func() {
+		resp, err := acctest.GetTestResty(t).R().
+			SetPathParams(map[string]string{
+				"projectKey": projectKey,
+				"name":       username3,
+			}).
+			SetBody(project.ProjectUserAPIModel{
+				Name:  username3,
+				Roles: []string{"Viewer"},
+			}).
+			Put(project.ProjectUsersUrl)
+		if err != nil {
+			t.Fatalf("failed to add user %s: %v", username3, err)
+		}
+		if resp.IsError() {
+			t.Fatalf("failed to add user %s: %s", username3, resp.String())
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"artifactory": {
+				Source: "jfrog/artifactory",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "authoritative", "false"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+				),
+			},
+			{
+				PreConfig: addUser,
+				Config:    config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+				),
+			},
+		},
+	})
+}
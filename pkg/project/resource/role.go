@@ -2,13 +2,23 @@ package project
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
 )
 
+// errProjectNotFound is returned when the roles of a project that does not exist yet are read,
+// e.g. at plan time when the project is created in the same apply.
+var errProjectNotFound = errors.New("project not found")
+
 type Role struct {
 	Name         string   `json:"name"`
 	Description  string   `json:"description"`
@@ -36,8 +46,9 @@ func filterRoles(roles []Role, roleType string) []Role {
 	return filteredRoles
 }
 
-var readRoles = func(ctx context.Context, projectKey string, client *resty.Client) ([]Role, error) {
-	tflog.Debug(ctx, "readRoles")
+// readAllRoles returns all the roles of the project, including the PREDEFINED ones.
+var readAllRoles = func(ctx context.Context, projectKey string, client *resty.Client) ([]Role, error) {
+	tflog.Debug(ctx, "readAllRoles")
 
 	var roles []Role
 
@@ -51,12 +62,53 @@ var readRoles = func(ctx context.Context, projectKey string, client *resty.Clien
 	if err != nil {
 		return nil, err
 	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", errProjectNotFound, projectError.String())
+	}
 	if resp.IsError() {
 		return nil, fmt.Errorf("%s", projectError.String())
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("roles: %+v\n", roles))
 
+	return roles, nil
+}
+
+// checkRolesExist returns an error listing the roles that do not exist in the project, predefined or custom.
+// plannedRoles are the custom roles created in the same apply, which are accepted as existing.
+// The API accepts unknown role names when assigning roles to members, but silently drops them.
+var checkRolesExist = func(ctx context.Context, projectKey string, roles, plannedRoles []string, client *resty.Client) error {
+	if len(roles) == 0 {
+		return nil
+	}
+
+	projectRoles, err := readAllRoles(ctx, projectKey, client)
+	if err != nil {
+		return err
+	}
+
+	availableRoles := lo.Uniq(append(lo.Map(projectRoles, func(role Role, _ int) string {
+		return role.Name
+	}), plannedRoles...))
+
+	missingRoles, _ := lo.Difference(lo.Uniq(roles), availableRoles)
+	if len(missingRoles) > 0 {
+		sort.Strings(missingRoles)
+		sort.Strings(availableRoles)
+		return fmt.Errorf("roles %s do not exist in project %s, available roles: %s", formatNames(missingRoles), projectKey, formatNames(availableRoles))
+	}
+
+	return nil
+}
+
+var readRoles = func(ctx context.Context, projectKey string, client *resty.Client) ([]Role, error) {
+	tflog.Debug(ctx, "readRoles")
+
+	roles, err := readAllRoles(ctx, projectKey, client)
+	if err != nil {
+		return nil, err
+	}
+
 	// REST API returns all project roles, including ones with PREDEFINED type which can't be altered.
 	// We are only interested in the "CUSTOM" types that we can manipulate.
 	customRoles := filterRoles(roles, customRoleType)
@@ -65,6 +117,38 @@ var readRoles = func(ctx context.Context, projectKey string, client *resty.Clien
 	return customRoles, nil
 }
 
+// checkPlannedRoles fails the plan when the roles assigned by a `project_user` or `project_group`
+// do not exist in the project. Roles created in the same apply by `project_role` resources are
+// referenced by name, e.g. `project_role.<name>.name`, so they are not known yet at plan time.
+// Nothing is verified when the roles or the project are not known yet.
+func checkPlannedRoles(ctx context.Context, projectKey string, roles types.Set, client *resty.Client) diag.Diagnostics {
+	ds := diag.Diagnostics{}
+
+	if client == nil || roles.IsUnknown() {
+		return ds
+	}
+
+	roleNames := []string{}
+	for _, elem := range roles.Elements() {
+		name, ok := elem.(types.String)
+		if !ok || name.IsUnknown() {
+			return ds
+		}
+		roleNames = append(roleNames, name.ValueString())
+	}
+
+	err := checkRolesExist(ctx, projectKey, roleNames, nil, client)
+	if err != nil && !errors.Is(err, errProjectNotFound) {
+		ds.AddAttributeError(
+			path.Root("roles"),
+			"Invalid project role",
+			err.Error()+". Roles created by `project_role` must be referenced by name, e.g. `project_role.<name>.name`, so they are created first.",
+		)
+	}
+
+	return ds
+}
+
 var updateRoles = func(ctx context.Context, projectKey string, terraformRoles []Role, client *resty.Client) ([]Role, error) {
 	tflog.Debug(ctx, "updateRoles")
 	tflog.Trace(ctx, fmt.Sprintf("terraformRoles: %+v\n", terraformRoles))
@@ -90,14 +174,14 @@ var updateRoles = func(ctx context.Context, projectKey string, terraformRoles []
 	for _, role := range rolesToBeAdded {
 		err := addRole(ctx, projectKey, role, client)
 		if err != nil {
-			return nil, fmt.Errorf("failed to add role %s: %s", role, err)
+			return nil, fmt.Errorf("failed to add role %s: %s", role.Name, err)
 		}
 	}
 
 	for _, role := range rolesToBeUpdated {
 		err := updateRole(ctx, projectKey, role, client)
 		if err != nil {
-			return nil, fmt.Errorf("failed to update role %s: %s", role, err)
+			return nil, fmt.Errorf("failed to update role %s: %s", role.Name, err)
 		}
 	}
 
@@ -162,7 +246,7 @@ var deleteRoles = func(ctx context.Context, projectKey string, roles []Role, cli
 	for _, role := range roles {
 		err := deleteRole(ctx, projectKey, role, client)
 		if err != nil {
-			return fmt.Errorf("failed to delete role %s: %s", role, err)
+			return fmt.Errorf("failed to delete role %s: %s", role.Name, err)
 		}
 	}
 
@@ -0,0 +1,158 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+)
+
+const storageInfoUrl = "/artifactory/api/storageinfo"
+
+// storageUsageWarnedPrivateStateKey records that the storage usage could not be read, so the
+// warning is not repeated on every refresh.
+const storageUsageWarnedPrivateStateKey = "storage_usage_warned"
+
+type RepositorySummaryAPIModel struct {
+	RepoKey          string `json:"repoKey"`
+	UsedSpaceInBytes int64  `json:"usedSpaceInBytes"`
+}
+
+type StorageInfoAPIModel struct {
+	RepositoriesSummaryList []RepositorySummaryAPIModel `json:"repositoriesSummaryList"`
+}
+
+// readUsedStorage returns the total space used by the repositories assigned to the project,
+// as reported by the platform's storage summary.
+var readUsedStorage = func(ctx context.Context, projectKey string, client *resty.Client) (int64, error) {
+	tflog.Debug(ctx, "readUsedStorage")
+
+	repoKeys, err := readRepos(ctx, projectKey, client)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch repos for project: %s", err)
+	}
+
+	var storageInfo StorageInfoAPIModel
+	resp, err := client.R().
+		SetResult(&storageInfo).
+		Get(storageInfoUrl)
+	if err != nil {
+		return 0, err
+	}
+	if resp.IsError() {
+		return 0, fmt.Errorf("failed to fetch storage summary: %s", resp.String())
+	}
+
+	usedBytes := lo.SumBy(storageInfo.RepositoriesSummaryList, func(summary RepositorySummaryAPIModel) int64 {
+		if lo.Contains(repoKeys, summary.RepoKey) {
+			return summary.UsedSpaceInBytes
+		}
+		return 0
+	})
+
+	tflog.Trace(ctx, fmt.Sprintf("readUsedStorage: %d bytes for repos %s", usedBytes, repoKeys))
+
+	return usedBytes, nil
+}
+
+// storageUsage returns the percentage of the quota used and whether it is exceeded.
+// A quota of -1 (unlimited) is never exceeded.
+func storageUsage(usedBytes, quotaBytes int64) (float64, bool) {
+	if quotaBytes <= 0 {
+		return 0, false
+	}
+
+	return float64(usedBytes) / float64(quotaBytes) * 100, usedBytes >= quotaBytes
+}
+
+var storageSizeRegex = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*(B|KiB|MiB|GiB|TiB|PiB)?$`)
+
+type storageUnit struct {
+	name  string
+	bytes int64
+}
+
+// storageUnits are ordered from the largest so that formatStorageSize picks the largest exact unit
+var storageUnits = []storageUnit{
+	{"PiB", 1 << 50},
+	{"TiB", 1 << 40},
+	{"GiB", 1 << 30},
+	{"MiB", 1 << 20},
+	{"KiB", 1 << 10},
+	{"B", 1},
+}
+
+// parseStorageSize converts a size such as "500GiB", "1.5TiB" or "1073741824" (bytes) to an exact
+// number of bytes. Fractional sizes must amount to a whole number of bytes.
+func parseStorageSize(size string) (int64, error) {
+	matches := storageSizeRegex.FindStringSubmatch(size)
+	if matches == nil {
+		return 0, fmt.Errorf("invalid storage size '%s', expected a number of bytes or a number followed by one of B, KiB, MiB, GiB, TiB, PiB, e.g. '500GiB'", size)
+	}
+
+	value, ok := new(big.Rat).SetString(matches[1])
+	if !ok {
+		return 0, fmt.Errorf("invalid storage size '%s'", size)
+	}
+
+	unit := "B"
+	if matches[2] != "" {
+		unit = matches[2]
+	}
+	matchedUnit, _ := lo.Find(storageUnits, func(u storageUnit) bool {
+		return u.name == unit
+	})
+	value.Mul(value, new(big.Rat).SetInt64(matchedUnit.bytes))
+
+	if !value.IsInt() {
+		return 0, fmt.Errorf("storage size '%s' is not a whole number of bytes", size)
+	}
+	if !value.Num().IsInt64() || value.Num().Int64() > GibibytesToBytes(MaxStorageInGibibytes) {
+		return 0, fmt.Errorf("storage size '%s' is larger than the maximum of %d GiB", size, MaxStorageInGibibytes)
+	}
+	if value.Num().Int64() < 1 {
+		return 0, fmt.Errorf("storage size '%s' must be at least 1 byte", size)
+	}
+
+	return value.Num().Int64(), nil
+}
+
+// formatStorageSize converts a number of bytes to the largest unit that represents it exactly,
+// e.g. 536870912000 to "500GiB".
+func formatStorageSize(bytes int64) string {
+	for _, unit := range storageUnits {
+		if bytes%unit.bytes == 0 {
+			if unit.name == "B" {
+				return fmt.Sprintf("%d", bytes)
+			}
+			return fmt.Sprintf("%d%s", bytes/unit.bytes, unit.name)
+		}
+	}
+
+	return fmt.Sprintf("%d", bytes)
+}
+
+type storageSizeValidator struct{}
+
+func (v storageSizeValidator) Description(_ context.Context) string {
+	return "value must be a number of bytes or a number followed by one of B, KiB, MiB, GiB, TiB, PiB"
+}
+
+func (v storageSizeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v storageSizeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseStorageSize(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Storage Size", err.Error())
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"sync"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/samber/lo"
 )
@@ -21,6 +23,24 @@ func RetryOnSpecificMsgBody(matchString string) func(response *resty.Response, e
 	}
 }
 
+func unableToInvokeActionError(resp *action.InvokeResponse, errMsg string) {
+	resp.Diagnostics.AddError(
+		"Unable to Invoke Action",
+		"An unexpected error occurred while invoking the action. "+
+			"Please report this issue to the provider developers.\n\n"+
+			"Error: "+errMsg,
+	)
+}
+
+func unableToReadDataSourceError(resp *datasource.ReadResponse, errMsg string) {
+	resp.Diagnostics.AddError(
+		"Unable to Read Data Source",
+		"An unexpected error occurred while reading the data source. "+
+			"Please report this issue to the provider developers.\n\n"+
+			"Error: "+errMsg,
+	)
+}
+
 type ProjectError struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`